@@ -3,5 +3,54 @@ package configs
 type GameType string
 
 const (
-	TenPin GameType = "TEN_PIN"
+	TenPin      GameType = "TEN_PIN"
+	TenPinNoTap GameType = "TEN_PIN_NO_TAP"
+	Candlepin   GameType = "CANDLEPIN"
+	FivePin     GameType = "FIVE_PIN"
+	Duckpin     GameType = "DUCKPIN"
+	// Custom is the game type of games played with the rules set when starting the game
+	Custom GameType = "CUSTOM"
+	// Baker is the team game type where the bowlers of a team take turns bowling the frames of the team
+	Baker GameType = "BAKER"
+	// ScotchDoubles is the game type where the 2 partners of a pair alternate deliveries
+	ScotchDoubles GameType = "SCOTCH_DOUBLES"
+	// RollOff is the game type of roll-offs breaking a tie in a completed game, which are started from the tied game
+	RollOff GameType = "ROLL_OFF"
+)
+
+type ScoringSystem string
+
+const (
+	// Traditional is the scoring system where a strike or spare takes the next rolls as bonus
+	Traditional ScoringSystem = "TRADITIONAL"
+	// CurrentFrame is the World Bowling scoring system, where a frame is scored without the next rolls
+	CurrentFrame ScoringSystem = "CURRENT_FRAME"
+)
+
+type GameStatus string
+
+const (
+	InProgress GameStatus = "IN_PROGRESS"
+	// Completed is the status of a game where all players have completed their last frame
+	Completed GameStatus = "COMPLETED"
+	Abandoned GameStatus = "ABANDONED"
+)
+
+type RollOffFormat string
+
+const (
+	// NinthTenthFrame is the roll-off where the tied players bowl a 9th and a 10th frame
+	NinthTenthFrame RollOffFormat = "NINTH_TENTH_FRAME"
+	// OneBall is the sudden death roll-off where each tied player rolls one ball
+	OneBall RollOffFormat = "ONE_BALL"
+)
+
+type AuditAction string
+
+const (
+	// Correction is the audit action of a privileged correction of a frame result
+	Correction AuditAction = "CORRECTION"
+	// CorrectionUndone and CorrectionRedone are the audit actions of undoing and redoing a correction
+	CorrectionUndone AuditAction = "UNDO"
+	CorrectionRedone AuditAction = "REDO"
 )
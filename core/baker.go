@@ -0,0 +1,81 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"bowling-score-tracker/configs"
+)
+
+// BakerGame implements the Baker format, where the bowlers of a team take turns bowling the frames of a single
+// team game, eg with 5 bowlers, bowler 1 bowls frames 1 and 6, bowler 2 bowls frames 2 and 7, and so on.
+// The players of the game are the teams, and the bowler of each frame is recorded.
+type BakerGame struct {
+	TenPinGame
+	// order contains the bowlers of each team in the order they bowl the frames
+	order [][]string
+}
+
+func (b *BakerGame) GetGameType() configs.GameType {
+	return configs.Baker
+}
+
+// StartGame starts a Baker game between teams, where the bowlers of each team are set in the options of the game
+func (b *BakerGame) StartGame(teamNames []string) error {
+	if len(b.order) != len(teamNames) {
+		return errors.New("each team requires the order of its bowlers")
+	}
+	for i, bowlers := range b.order {
+		if len(bowlers) == 0 {
+			return fmt.Errorf("team at index %d has no bowler", i)
+		}
+		for _, e := range bowlers {
+			if e == "" {
+				return fmt.Errorf("team at index %d has a bowler with empty name", i)
+			}
+		}
+	}
+	if err := b.startGame(teamNames, tenPinRules); err != nil {
+		return err
+	}
+
+	for i, player := range b.players {
+		for frame := range player.bowlers {
+			player.bowlers[frame] = b.order[i][frame%len(b.order[i])]
+		}
+	}
+	return nil
+}
+
+func (b *BakerGame) AddPlayer(name string, blindScore *int) error {
+	return errors.New("teams can't be added to Baker games")
+}
+
+// SubstitutePlayer replaces a bowler of a team from a frame on, who is the bowler of that frame.
+// The substitute bowls the following frames of the replaced bowler, and the other bowlers keep their frames.
+func (b *BakerGame) SubstitutePlayer(playerIndex int, name string, fromFrame int) error {
+	if err := b.checkInProgress(); err != nil {
+		return err
+	}
+	player, err := b.getActivePlayer(playerIndex)
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		return errors.New("name is empty")
+	}
+	if fromFrame < 0 || fromFrame >= len(player.frames) {
+		return errors.New("invalid frame index")
+	}
+	if fromFrame < player.firstUnbowledFrame() {
+		return errors.New("frame has already been played")
+	}
+
+	replaced := player.bowlers[fromFrame]
+	for i := fromFrame; i < len(player.bowlers); i++ {
+		if player.bowlers[i] == replaced {
+			player.bowlers[i] = name
+		}
+	}
+	return nil
+}
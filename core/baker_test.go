@@ -0,0 +1,107 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"bowling-score-tracker/configs"
+)
+
+var bakerOrder = [][]string{{"a1", "a2", "a3", "a4", "a5"}, {"b1", "b2"}}
+
+func TestBakerGame(t *testing.T) {
+	t.Run("StartGame", func(t *testing.T) {
+		t.Run("should_rotate_bowlers_of_each_team", func(t *testing.T) {
+			game := &BakerGame{order: bakerOrder}
+
+			err := game.StartGame([]string{"A", "B"})
+
+			require.NoError(t, err)
+			assert.Equal(t, []string{"a1", "a2", "a3", "a4", "a5", "a1", "a2", "a3", "a4", "a5"}, game.GetPlayers()[0].GetBowlers())
+			assert.Equal(t, []string{"b1", "b2", "b1", "b2", "b1", "b2", "b1", "b2", "b1", "b2"}, game.GetPlayers()[1].GetBowlers())
+		})
+
+		t.Run("should_reject_invalid_order", func(t *testing.T) {
+			assert.Error(t, (&BakerGame{order: bakerOrder[:1]}).StartGame([]string{"A", "B"}), "missing order")
+			assert.Error(t, (&BakerGame{order: [][]string{{"a1"}, {}}}).StartGame([]string{"A", "B"}), "no bowler")
+			assert.Error(t, (&BakerGame{order: [][]string{{"a1"}, {""}}}).StartGame([]string{"A", "B"}), "empty name")
+		})
+	})
+
+	t.Run("SubstitutePlayer", func(t *testing.T) {
+		t.Run("should_replace_frames_of_one_bowler", func(t *testing.T) {
+			game := &BakerGame{order: bakerOrder}
+			require.NoError(t, game.StartGame([]string{"A", "B"}))
+
+			err := game.SubstitutePlayer(1, "b3", 3)
+
+			require.NoError(t, err)
+			assert.Equal(t, "B", game.GetPlayers()[1].name)
+			assert.Equal(t, []string{"b1", "b2", "b1", "b3", "b1", "b3", "b1", "b3", "b1", "b3"}, game.GetPlayers()[1].GetBowlers())
+		})
+
+		t.Run("should_reject_frames_already_played", func(t *testing.T) {
+			game := &BakerGame{order: bakerOrder}
+			require.NoError(t, game.StartGame([]string{"A", "B"}))
+			require.NoError(t, game.SetFrameResult(1, 3, 4))
+			game.NextFrame()
+
+			assert.Error(t, game.SubstitutePlayer(1, "b3", 0))
+			assert.Equal(t, []string{"b1", "b2"}, game.GetPlayers()[1].GetBowlers()[:2])
+		})
+	})
+
+	t.Run("should_reject_late_team", func(t *testing.T) {
+		game := &BakerGame{order: bakerOrder}
+		require.NoError(t, game.StartGame([]string{"A", "B"}))
+
+		assert.Error(t, game.AddPlayer("C", nil))
+	})
+}
+
+func TestPlayer_GetBowlerStats(t *testing.T) {
+	t.Run("should_sum_frames_of_each_bowler", func(t *testing.T) {
+		game := &BakerGame{order: [][]string{{"a1", "a2"}}}
+		require.NoError(t, game.StartGame([]string{"A"}))
+		require.NoError(t, game.SetFrameResult(0, 10))
+		game.NextFrame()
+		require.NoError(t, game.SetFrameResult(0, 7, 3))
+		game.NextFrame()
+		require.NoError(t, game.SetFrameResult(0, Foul, 4))
+		game.NextFrame()
+		require.NoError(t, game.SetFrameResult(0, 6, Foul))
+		game.NextFrame()
+
+		stats := game.GetPlayers()[0].GetBowlerStats()
+
+		assert.Equal(t, []BowlerStats{
+			{Name: "a1", Frames: 2, Strikes: 1, Pins: 14, Score: 24, Fouls: 1},
+			{Name: "a2", Frames: 2, Spares: 1, Pins: 16, Score: 16, Fouls: 1},
+		}, stats)
+	})
+}
+
+func TestGameManager_Baker(t *testing.T) {
+	t.Run("should_return_bowler_stats_of_teams", func(t *testing.T) {
+		m := NewGameManager()
+		startGameRes, err := m.StartGame(configs.Baker, []string{"A", "B"}, GameOptions{BakerOrder: bakerOrder})
+		require.NoError(t, err)
+		_, err = m.SetFrameResult(startGameRes.Id, 0, 3, 4)
+		require.NoError(t, err)
+		_, err = m.NextFrame(startGameRes.Id)
+		require.NoError(t, err)
+
+		res, err := m.SetFrameResult(startGameRes.Id, 0, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, configs.Baker, res.GameType)
+		assert.Equal(t, []BowlerStats{
+			{Name: "a1", Frames: 1, Pins: 7, Score: 7},
+			{Name: "a2", Frames: 1, Strikes: 1, Pins: 10, Score: 10},
+		}, res.Players[0].BowlerStats)
+		// the second frame of team B is in progress, so b1 is the only bowler so far
+		assert.Nil(t, res.Players[1].BowlerStats)
+	})
+}
@@ -0,0 +1,76 @@
+package core
+
+import (
+	"errors"
+
+	"github.com/samber/lo"
+)
+
+// BlindRule is the league rule which computes the score of an absent bowler, called a blind
+type BlindRule struct {
+	// Score is the fixed score of a blind. When it is 0, the score is the average of the bowler minus Penalty.
+	Score int `json:"score"`
+	// Penalty is deducted from the average of the bowler, eg 10
+	Penalty int `json:"penalty"`
+}
+
+// Validate checks that the rule gives a score
+func (b BlindRule) Validate() error {
+	if b.Score < 0 || b.Penalty < 0 {
+		return errors.New("blind score and penalty can't be negative")
+	}
+	return nil
+}
+
+// blindScore returns the score of a blind bowler with an average
+func (b BlindRule) blindScore(average int) int {
+	if b.Score > 0 {
+		return b.Score
+	}
+	return max(average-b.Penalty, 0)
+}
+
+// MarkBlind scores an absent player with a fixed score instead of rolls.
+// A blind player can't bowl, and doesn't hold the game back.
+func (g *baseGame) MarkBlind(playerIndex int, score int) error {
+	if err := g.checkInProgress(); err != nil {
+		return err
+	}
+	player, err := g.getActivePlayer(playerIndex)
+	if err != nil {
+		return err
+	}
+	if lo.SomeBy(player.frames, func(item Frame) bool {
+		return len(item.GetPins()) > 0
+	}) {
+		return errors.New("player has already bowled")
+	}
+	if score < 0 || score > player.GetMaxScore() {
+		return errors.New("invalid blind score")
+	}
+
+	player.blindScore = &score
+	// nobody bowls the frames of a blind
+	for i := range player.bowlers {
+		player.bowlers[i] = ""
+	}
+	return nil
+}
+
+// IsBlind returns whether the player is scored with a blind score instead of rolls
+func (p *Player) IsBlind() bool {
+	return p.blindScore != nil
+}
+
+// GetTotalScore returns the sum of the scores of all frames, or the blind score of a blind player
+func (p *Player) GetTotalScore() int {
+	if p.blindScore != nil {
+		return *p.blindScore
+	}
+	return lo.Sum(p.GetScores())
+}
+
+// isActive returns whether the player still bowls, ie the player hasn't withdrawn and isn't blind
+func (p *Player) isActive() bool {
+	return !p.withdrawn && p.blindScore == nil
+}
@@ -0,0 +1,143 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"bowling-score-tracker/configs"
+)
+
+func TestBlindRule(t *testing.T) {
+	t.Run("should_deduct_penalty_from_average", func(t *testing.T) {
+		assert.Equal(t, 140, BlindRule{Penalty: 10}.blindScore(150))
+		assert.Equal(t, 0, BlindRule{Penalty: 10}.blindScore(5))
+	})
+
+	t.Run("should_use_fixed_score_when_set", func(t *testing.T) {
+		assert.Equal(t, 120, BlindRule{Score: 120, Penalty: 10}.blindScore(150))
+	})
+
+	t.Run("should_reject_negative_values", func(t *testing.T) {
+		assert.Error(t, BlindRule{Score: -1}.Validate())
+		assert.Error(t, BlindRule{Penalty: -1}.Validate())
+	})
+}
+
+func TestTenPinGame_MarkBlind(t *testing.T) {
+	t.Run("should_score_blind_without_rolls", func(t *testing.T) {
+		game := &TenPinGame{}
+		require.NoError(t, game.StartGame([]string{"hung", "thuy"}))
+
+		err := game.MarkBlind(1, 140)
+
+		require.NoError(t, err)
+		player := game.GetPlayers()[1]
+		assert.True(t, player.IsBlind())
+		assert.Equal(t, 140, player.GetTotalScore())
+		assert.Equal(t, 140, player.GetMaxScore())
+		assert.Equal(t, 140, player.GetMinScore())
+		assert.Equal(t, make([]*int, 10), player.GetCumulativeScores())
+		assert.Equal(t, make([]string, 10), player.GetBowlers())
+		assert.Error(t, game.SetFrameResult(1, 3, 4), "blind is exempt from frame entry")
+	})
+
+	t.Run("should_reject_correction_of_blind", func(t *testing.T) {
+		game := &TenPinGame{}
+		require.NoError(t, game.StartGame([]string{"hung", "thuy"}))
+		require.NoError(t, game.MarkBlind(1, 120))
+
+		err := game.CorrectFrame(1, 0, 10)
+
+		assert.EqualError(t, err, "player is blind")
+		player := game.GetPlayers()[1]
+		assert.Empty(t, player.GetFrameResults()[0])
+		assert.Equal(t, 0, lo.Sum(player.GetScores()))
+		assert.Equal(t, 120, player.GetTotalScore())
+	})
+
+	t.Run("should_not_hold_the_game_back", func(t *testing.T) {
+		game := &TenPinGame{}
+		require.NoError(t, game.StartGame([]string{"hung", "thuy"}))
+		require.NoError(t, game.MarkBlind(1, 140))
+
+		assert.Equal(t, 1, game.NextFrame())
+		assert.Equal(t, 0, game.GetPlayers()[1].GetCurrentFrame())
+
+		game.players[0].currentFrame = 9
+		require.NoError(t, game.SetFrameResult(0, 10, 10, 10))
+		assert.Equal(t, configs.Completed, game.GetStatus())
+		assert.Equal(t, []Standing{{Place: 1, PlayerIndex: 0, Name: "hung", TotalScore: 30, Winner: true}}, game.GetStandings())
+	})
+
+	t.Run("should_reject_player_who_has_bowled", func(t *testing.T) {
+		game := &TenPinGame{}
+		require.NoError(t, game.StartGame([]string{"hung"}))
+		_, err := game.Roll(0, 3)
+		require.NoError(t, err)
+
+		assert.Error(t, game.MarkBlind(0, 140))
+	})
+
+	t.Run("should_reject_invalid_score", func(t *testing.T) {
+		game := &TenPinGame{}
+		require.NoError(t, game.StartGame([]string{"hung"}))
+
+		assert.Error(t, game.MarkBlind(0, -1))
+		assert.Error(t, game.MarkBlind(0, 301))
+		assert.False(t, game.GetPlayers()[0].IsBlind())
+	})
+}
+
+func TestGameManager_MarkBlind(t *testing.T) {
+	t.Run("should_reject_invalid_blind_rule", func(t *testing.T) {
+		m := NewGameManager()
+
+		_, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{BlindRule: BlindRule{Penalty: -10}})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should_score_blind_with_blind_rule_of_game", func(t *testing.T) {
+		m := NewGameManager()
+		startGameRes, err := m.StartGame(configs.TenPin, []string{"hung", "thuy"}, GameOptions{BlindRule: BlindRule{Penalty: 10}})
+		require.NoError(t, err)
+
+		res, err := m.MarkBlind(startGameRes.Id, 1, lo.ToPtr(150))
+
+		require.NoError(t, err)
+		assert.True(t, res.Players[1].Blind)
+		assert.Equal(t, 140, res.Players[1].TotalScore)
+	})
+
+	t.Run("should_use_stored_average_when_average_is_not_set", func(t *testing.T) {
+		m := NewGameManager()
+		startGameRes, err := m.StartGame(configs.TenPin, []string{"hung", "thuy", "lan"}, GameOptions{
+			BlindRule: BlindRule{Penalty: 10},
+			Players:   []PlayerOptions{{}, {Average: lo.ToPtr(170)}},
+		})
+		require.NoError(t, err)
+
+		res, err := m.MarkBlind(startGameRes.Id, 1, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 160, res.Players[1].TotalScore)
+
+		_, err = m.MarkBlind(startGameRes.Id, 2, nil)
+		assert.Error(t, err, "the average of lan is unknown")
+		_, err = m.MarkBlind(startGameRes.Id, 2, lo.ToPtr(-1))
+		assert.Error(t, err, "invalid average")
+	})
+
+	t.Run("should_not_require_average_with_fixed_blind_score", func(t *testing.T) {
+		m := NewGameManager()
+		startGameRes, err := m.StartGame(configs.TenPin, []string{"hung", "thuy"}, GameOptions{BlindRule: BlindRule{Score: 120}})
+		require.NoError(t, err)
+
+		res, err := m.MarkBlind(startGameRes.Id, 1, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, 120, res.Players[1].TotalScore)
+	})
+}
@@ -0,0 +1,31 @@
+package core
+
+import "bowling-score-tracker/configs"
+
+var candlepinRules = Rules{
+	NumFrames:        10,
+	NumPins:          numPin,
+	BallsPerFrame:    3,
+	StrikeBonusBalls: 2,
+	SpareBonusBalls:  1,
+	StrikeFillBalls:  2,
+	SpareFillBalls:   1,
+	MaxPlayers:       maxPlayer,
+}
+
+// CandlepinGame implements the rule of candlepin bowling.
+// Each box (frame) allows up to 3 balls and the downed wood stays on the deck between balls,
+// so the pins knocked in a box never exceed 10 unless the rack is reset in the 10th box.
+// A strike counts the next 2 balls as bonus, a spare (10 pins with 2 balls) counts the next ball,
+// and 10 pins with all 3 balls (a ten-box) scores 10 without bonus.
+type CandlepinGame struct {
+	TenPinGame
+}
+
+func (c *CandlepinGame) GetGameType() configs.GameType {
+	return configs.Candlepin
+}
+
+func (c *CandlepinGame) StartGame(playerNames []string) error {
+	return c.startGame(playerNames, candlepinRules)
+}
@@ -0,0 +1,102 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCandlepinGame(t *testing.T) {
+	t.Run("StartGame", func(t *testing.T) {
+		t.Run("should_accept_up_to_5_players", func(t *testing.T) {
+			game := &CandlepinGame{}
+			assert.NoError(t, game.StartGame([]string{"hung1", "hung2", "hung3", "hung4", "hung5"}))
+			assert.Len(t, game.GetPlayers(), 5)
+		})
+
+		t.Run("should_reject_empty_names", func(t *testing.T) {
+			game := &CandlepinGame{}
+			assert.Error(t, game.StartGame([]string{"hung", ""}))
+		})
+	})
+
+	t.Run("SetFrameResult", func(t *testing.T) {
+		t.Run("should_reject_invalid_scores_input", func(t *testing.T) {
+			t.Run("for_normal_box", func(t *testing.T) {
+				game := &CandlepinGame{}
+				require.NoError(t, game.StartGame([]string{"hung"}))
+
+				assert.Error(t, game.SetFrameResult(0, 3, 4), "open box require 3 balls")
+				assert.Error(t, game.SetFrameResult(0, 3, 7, 0), "spare box require 2 balls")
+				assert.Error(t, game.SetFrameResult(0, 5, 4, 2), "box can't knock more than 10 pins")
+				assert.Error(t, game.SetFrameResult(0, 1, 1, 1, 1), "box can't have more than 3 balls")
+			})
+
+			t.Run("for_last_box", func(t *testing.T) {
+				game := &CandlepinGame{}
+				require.NoError(t, game.StartGame([]string{"hung"}))
+				game.players[0].currentFrame = 9
+
+				assert.Error(t, game.SetFrameResult(0, 3, 4), "open box require 3 balls")
+				assert.Error(t, game.SetFrameResult(0, 10, 4), "strike box require 3 balls")
+				assert.Error(t, game.SetFrameResult(0, 5, 3, 3), "open box can't knock more than 10 pins")
+			})
+		})
+
+		t.Run("normal_box_open", func(t *testing.T) {
+			game := &CandlepinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			err := game.SetFrameResult(0, 3, 2, 4)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []int{3, 2, 4}, game.GetPlayers()[0].frames[0].GetPins())
+		})
+
+		t.Run("normal_box_spare", func(t *testing.T) {
+			game := &CandlepinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			err := game.SetFrameResult(0, 3, 7)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []int{3, 7}, game.GetPlayers()[0].frames[0].GetPins())
+		})
+
+		t.Run("last_box_open", func(t *testing.T) {
+			game := &CandlepinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			game.players[0].currentFrame = 9
+
+			err := game.SetFrameResult(0, 5, 2, 1)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []int{5, 2, 1}, game.GetPlayers()[0].frames[9].GetPins())
+		})
+	})
+
+	t.Run("GetScores", func(t *testing.T) {
+		t.Run("perfect_game", func(t *testing.T) {
+			player := newPlayer("max", &candlepinRules)
+			for i := 0; i < 9; i++ {
+				require.NoError(t, player.frames[i].KnockPins(10))
+			}
+			require.NoError(t, player.frames[9].KnockPins(10, 10, 10))
+
+			expected := []int{30, 30, 30, 30, 30, 30, 30, 30, 30, 30}
+			assert.Equal(t, expected, player.GetScores())
+		})
+
+		t.Run("ten_box_has_no_bonus", func(t *testing.T) {
+			player := newPlayer("ten", &candlepinRules)
+			require.NoError(t, player.frames[0].KnockPins(5, 3, 2))
+			require.NoError(t, player.frames[1].KnockPins(6, 4))
+			require.NoError(t, player.frames[2].KnockPins(10))
+			require.NoError(t, player.frames[3].KnockPins(4, 2, 1))
+
+			expected := []int{10, 20, 16, 7, 0, 0, 0, 0, 0, 0}
+			assert.Equal(t, expected, player.GetScores())
+		})
+	})
+}
@@ -0,0 +1,65 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"bowling-score-tracker/configs"
+)
+
+// CustomGame implements a bowling variant defined by declarative rules, eg a house game.
+type CustomGame struct {
+	TenPinGame
+	gameType    configs.GameType
+	customRules *Rules
+}
+
+func newCustomGame(t configs.GameType, rules *Rules, opts GameOptions) *CustomGame {
+	if opts.ScoringSystem == "" && rules != nil {
+		opts.ScoringSystem = rules.ScoringSystem
+	}
+
+	return &CustomGame{
+		TenPinGame:  newTenPinGame(opts),
+		gameType:    t,
+		customRules: rules,
+	}
+}
+
+func (c *CustomGame) GetGameType() configs.GameType {
+	return c.gameType
+}
+
+func (c *CustomGame) StartGame(playerNames []string) error {
+	if c.customRules == nil {
+		return errors.New("rules are required for custom games")
+	}
+
+	return c.startGame(playerNames, *c.customRules)
+}
+
+// GameTypeSpec is the declarative definition of a custom game type, eg a house game loaded from a JSON file
+type GameTypeSpec struct {
+	GameType    configs.GameType `json:"game_type"`
+	DisplayName string           `json:"display_name"`
+	Rules       Rules            `json:"rules"`
+}
+
+// RegisterGameTypeSpec validates a custom game type and makes it available to GameManager
+func RegisterGameTypeSpec(spec GameTypeSpec) error {
+	if spec.GameType == "" {
+		return errors.New("game_type is empty")
+	}
+	if _, ok := getGameType(spec.GameType); ok {
+		return fmt.Errorf("game type %s already exists", spec.GameType)
+	}
+	if err := spec.Rules.Validate(); err != nil {
+		return fmt.Errorf("invalid rules of game type %s: %w", spec.GameType, err)
+	}
+
+	RegisterGameType(newGameTypeInfo(spec.GameType, spec.DisplayName, spec.Rules), func(opts GameOptions) Game {
+		rules := spec.Rules
+		return newCustomGame(spec.GameType, &rules, opts)
+	})
+	return nil
+}
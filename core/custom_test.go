@@ -0,0 +1,162 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"bowling-score-tracker/configs"
+)
+
+func TestCustomGame(t *testing.T) {
+	kidsRules := tenPinRules
+	kidsRules.NumFrames = 5
+
+	t.Run("StartGame", func(t *testing.T) {
+		t.Run("should_reject_missing_rules", func(t *testing.T) {
+			game := newCustomGame(configs.Custom, nil, GameOptions{})
+			assert.Error(t, game.StartGame([]string{"hung"}))
+		})
+
+		t.Run("should_reject_invalid_rules", func(t *testing.T) {
+			rules := tenPinRules
+			rules.BallsPerFrame = 0
+
+			game := newCustomGame(configs.Custom, &rules, GameOptions{})
+
+			assert.Error(t, game.StartGame([]string{"hung"}))
+		})
+
+		t.Run("should_reject_more_than_max_players_of_rules", func(t *testing.T) {
+			rules := tenPinRules
+			rules.MaxPlayers = 1
+
+			game := newCustomGame(configs.Custom, &rules, GameOptions{})
+
+			assert.Error(t, game.StartGame([]string{"hung", "thuy"}))
+		})
+
+		t.Run("should_create_frames_of_rules", func(t *testing.T) {
+			game := newCustomGame(configs.Custom, &kidsRules, GameOptions{})
+
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			assert.Len(t, game.GetPlayers()[0].frames, 5)
+		})
+	})
+
+	t.Run("NextFrame", func(t *testing.T) {
+		t.Run("should_stop_at_last_frame_of_rules", func(t *testing.T) {
+			game := newCustomGame(configs.Custom, &kidsRules, GameOptions{})
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			for i := 0; i < 10; i++ {
+				game.NextFrame()
+			}
+
+			assert.Equal(t, 4, game.GetCurrentFrame())
+		})
+	})
+
+	t.Run("GetScores", func(t *testing.T) {
+		t.Run("perfect_12_frame_game", func(t *testing.T) {
+			rules := tenPinRules
+			rules.NumFrames = 12
+			player := newPlayer("max", &rules)
+			for i := 0; i < 11; i++ {
+				require.NoError(t, player.frames[i].KnockPins(10))
+			}
+			require.NoError(t, player.frames[11].KnockPins(10, 10, 10))
+
+			assert.Equal(t, []int{30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30}, player.GetScores())
+		})
+
+		t.Run("spare_with_2_bonus_balls", func(t *testing.T) {
+			rules := tenPinRules
+			rules.SpareBonusBalls = 2
+			player := newPlayer("spare", &rules)
+			require.NoError(t, player.frames[0].KnockPins(4, 6))
+			require.NoError(t, player.frames[1].KnockPins(3, 5))
+
+			assert.Equal(t, []int{18, 8}, player.GetScores()[:2])
+		})
+
+		t.Run("spares_count_double", func(t *testing.T) {
+			rules := kidsRules
+			rules.SpareMultiplier = 2
+			player := newPlayer("spare", &rules)
+			require.NoError(t, player.frames[0].KnockPins(4, 6))
+			require.NoError(t, player.frames[1].KnockPins(3, 5))
+			require.NoError(t, player.frames[2].KnockPins(10))
+			require.NoError(t, player.frames[3].KnockPins(3, 5))
+			require.NoError(t, player.frames[4].KnockPins(7, 3, 4))
+
+			assert.Equal(t, []int{26, 8, 18, 8, 28}, player.GetScores(), "strikes and open frames are not doubled")
+		})
+
+		t.Run("spares_count_double_with_current_frame_scoring", func(t *testing.T) {
+			rules := kidsRules
+			rules.SpareMultiplier = 2
+			rules.ScoringSystem = configs.CurrentFrame
+			player := newPlayer("spare", &rules)
+			require.NoError(t, player.frames[0].KnockPins(4, 6))
+
+			assert.Equal(t, 28, player.GetScores()[0])
+		})
+
+		t.Run("last_frame_without_fill_balls", func(t *testing.T) {
+			rules := kidsRules
+			rules.StrikeFillBalls = 0
+			rules.SpareFillBalls = 0
+			player := newPlayer("nofill", &rules)
+
+			assert.Error(t, player.frames[4].KnockPins(10, 10, 10))
+			require.NoError(t, player.frames[4].KnockPins(10))
+			assert.Equal(t, 10, player.GetScores()[4])
+		})
+
+		t.Run("strike_and_spare_marks_of_12_pin_rack", func(t *testing.T) {
+			rules := kidsRules
+			rules.NumPins = 12
+			player := newPlayer("twelve", &rules)
+
+			assert.Error(t, player.frames[0].KnockPins(10), "10 pins don't clear a 12-pin rack")
+			require.NoError(t, player.frames[0].KnockPins(Strike))
+			require.NoError(t, player.frames[1].KnockPins(5, Spare))
+			require.NoError(t, player.frames[2].KnockPins(Foul, Spare))
+
+			assert.Equal(t, [][]int{{12}, {5, 7}, {0, 12}}, player.GetFrameResults()[:3])
+			assert.Equal(t, []int{24, 12}, player.GetScores()[:2])
+		})
+	})
+}
+
+func TestRegisterGameTypeSpec(t *testing.T) {
+	t.Run("should_register_valid_spec", func(t *testing.T) {
+		rules := tenPinRules
+		rules.NumFrames = 5
+
+		err := RegisterGameTypeSpec(GameTypeSpec{GameType: "KIDS_5_FRAME", DisplayName: "Kids", Rules: rules})
+
+		require.NoError(t, err)
+		gameType, ok := getGameType("KIDS_5_FRAME")
+		require.True(t, ok)
+		assert.Equal(t, 5, gameType.info.NumFrames)
+		game := gameType.factory(GameOptions{})
+		require.NoError(t, game.StartGame([]string{"hung"}))
+		assert.Equal(t, configs.GameType("KIDS_5_FRAME"), game.GetGameType())
+	})
+
+	t.Run("should_reject_existing_game_type", func(t *testing.T) {
+		err := RegisterGameTypeSpec(GameTypeSpec{GameType: configs.TenPin, Rules: tenPinRules})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should_reject_invalid_rules", func(t *testing.T) {
+		err := RegisterGameTypeSpec(GameTypeSpec{GameType: "INVALID"})
+
+		assert.Error(t, err)
+	})
+}
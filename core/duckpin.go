@@ -0,0 +1,31 @@
+package core
+
+import "bowling-score-tracker/configs"
+
+var duckpinRules = Rules{
+	NumFrames:        10,
+	NumPins:          numPin,
+	BallsPerFrame:    3,
+	StrikeBonusBalls: 2,
+	SpareBonusBalls:  1,
+	StrikeFillBalls:  2,
+	SpareFillBalls:   1,
+	MaxPlayers:       maxPlayer,
+}
+
+// DuckpinGame implements the rule of duckpin bowling.
+// Each frame allows up to 3 balls at 10 pins.
+// A strike (10 pins with the first ball) counts the next 2 balls as bonus,
+// a spare (10 pins with the second ball) counts the next ball,
+// and 10 pins with the third ball scores 10 without bonus.
+type DuckpinGame struct {
+	TenPinGame
+}
+
+func (d *DuckpinGame) GetGameType() configs.GameType {
+	return configs.Duckpin
+}
+
+func (d *DuckpinGame) StartGame(playerNames []string) error {
+	return d.startGame(playerNames, duckpinRules)
+}
@@ -0,0 +1,63 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDuckpinGame(t *testing.T) {
+	t.Run("SetFrameResult", func(t *testing.T) {
+		t.Run("should_reject_invalid_scores_input", func(t *testing.T) {
+			game := &DuckpinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			assert.Error(t, game.SetFrameResult(0, 7), "open frame require 3 balls")
+			assert.Error(t, game.SetFrameResult(0, 4, 4), "open frame require 3 balls")
+			assert.Error(t, game.SetFrameResult(0, 10, 0), "strike frame require 1 ball")
+			assert.Error(t, game.SetFrameResult(0, 4, 6, 0), "spare frame require 2 balls")
+			assert.Error(t, game.SetFrameResult(0, 4, 4, 4), "frame can't knock more than 10 pins")
+		})
+
+		t.Run("normal_frame_ten_with_third_ball", func(t *testing.T) {
+			game := &DuckpinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			err := game.SetFrameResult(0, 4, 4, 2)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []int{4, 4, 2}, game.GetPlayers()[0].frames[0].GetPins())
+		})
+
+		t.Run("last_frame_spare", func(t *testing.T) {
+			game := &DuckpinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			game.players[0].currentFrame = 9
+
+			err := game.SetFrameResult(0, 3, 7, 10)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []int{3, 7, 10}, game.GetPlayers()[0].frames[9].GetPins())
+		})
+	})
+
+	t.Run("GetScores", func(t *testing.T) {
+		t.Run("complete_game_with_strike_spare", func(t *testing.T) {
+			player := newPlayer("spare", &duckpinRules)
+			require.NoError(t, player.frames[0].KnockPins(10))
+			require.NoError(t, player.frames[1].KnockPins(2, 8))
+			require.NoError(t, player.frames[2].KnockPins(5, 3, 2))
+			require.NoError(t, player.frames[3].KnockPins(10))
+			require.NoError(t, player.frames[4].KnockPins(10))
+			require.NoError(t, player.frames[5].KnockPins(3, 3, 3))
+			require.NoError(t, player.frames[6].KnockPins(7, 3))
+			require.NoError(t, player.frames[7].KnockPins(1, 1, 1))
+			require.NoError(t, player.frames[8].KnockPins(4, 5, 0))
+			require.NoError(t, player.frames[9].KnockPins(10, 6, 1))
+
+			expected := []int{20, 15, 10, 23, 16, 9, 11, 3, 9, 17}
+			assert.Equal(t, expected, player.GetScores())
+		})
+	})
+}
@@ -0,0 +1,33 @@
+package core
+
+import "bowling-score-tracker/configs"
+
+// fivePinRules describes the rack of 5-pin bowling.
+// From left to right, the pins are the left 2, left 3, headpin 5, right 3 and right 2, which adds up to 15.
+var fivePinRules = Rules{
+	NumFrames:        10,
+	NumPins:          5,
+	PinValues:        []int{2, 3, 5, 3, 2},
+	BallsPerFrame:    3,
+	StrikeBonusBalls: 2,
+	SpareBonusBalls:  1,
+	StrikeFillBalls:  2,
+	SpareFillBalls:   1,
+	MaxPlayers:       maxPlayer,
+}
+
+// FivePinGame implements the rule of (Canadian) 5-pin bowling.
+// Each frame allows up to 3 balls, and the score of a roll is the sum of the values of the pins knocked,
+// so the result must be set with the knocked pins using SetFramePins.
+// A strike counts the next 2 balls as bonus, a spare (all pins with 2 balls) counts the next ball.
+type FivePinGame struct {
+	TenPinGame
+}
+
+func (f *FivePinGame) GetGameType() configs.GameType {
+	return configs.FivePin
+}
+
+func (f *FivePinGame) StartGame(playerNames []string) error {
+	return f.startGame(playerNames, fivePinRules)
+}
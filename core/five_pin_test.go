@@ -0,0 +1,223 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	leftTwo PinMask = 1 << iota
+	leftThree
+	headPin
+	rightThree
+	rightTwo
+	allFivePins = leftTwo | leftThree | headPin | rightThree | rightTwo
+)
+
+func TestFivePinGame(t *testing.T) {
+	t.Run("SetFrameResult", func(t *testing.T) {
+		t.Run("should_reject_numbers_of_pins", func(t *testing.T) {
+			game := &FivePinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			assert.Error(t, game.SetFrameResult(0, 5, 5, 5))
+		})
+	})
+
+	t.Run("SetFramePins", func(t *testing.T) {
+		t.Run("should_reject_invalid_pins_input", func(t *testing.T) {
+			game := &FivePinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			assert.Error(t, game.SetFramePins(0, headPin, headPin, 0), "pin can't be knocked twice")
+			assert.Error(t, game.SetFramePins(0, headPin, 1<<5, 0), "pin must be in the rack")
+			assert.Error(t, game.SetFramePins(0, headPin, leftTwo), "open frame require 3 balls")
+			assert.Error(t, game.SetFramePins(0, allFivePins, 0), "strike frame require 1 ball")
+		})
+
+		t.Run("normal_frame_strike", func(t *testing.T) {
+			game := &FivePinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			err := game.SetFramePins(0, allFivePins)
+
+			assert.NoError(t, err)
+			frame := game.GetPlayers()[0].frames[0]
+			assert.Equal(t, []int{15}, frame.GetPins())
+			assert.Equal(t, []PinMask{allFivePins}, frame.GetPinMasks())
+			assert.Nil(t, game.GetPlayers()[0].GetFrameSplits(), "splits are only detected on 10-pin racks")
+		})
+
+		t.Run("normal_frame_spare", func(t *testing.T) {
+			game := &FivePinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			err := game.SetFramePins(0, leftTwo|leftThree|headPin, rightThree|rightTwo)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []int{10, 5}, game.GetPlayers()[0].frames[0].GetPins())
+		})
+
+		t.Run("normal_frame_open", func(t *testing.T) {
+			game := &FivePinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			err := game.SetFramePins(0, headPin, leftTwo, 0)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []int{5, 2, 0}, game.GetPlayers()[0].frames[0].GetPins())
+		})
+
+		t.Run("last_frame_strike", func(t *testing.T) {
+			game := &FivePinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			game.players[0].currentFrame = 9
+
+			err := game.SetFramePins(0, allFivePins, allFivePins, headPin)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []int{15, 15, 5}, game.GetPlayers()[0].frames[9].GetPins())
+		})
+	})
+
+	t.Run("Roll", func(t *testing.T) {
+		t.Run("should_reject_numbers_of_pins", func(t *testing.T) {
+			game := &FivePinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			_, err := game.Roll(0, 5)
+
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run("RollPins", func(t *testing.T) {
+		t.Run("should_complete_frame_roll_by_roll", func(t *testing.T) {
+			game := &FivePinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			var completes []bool
+			for _, knocked := range []PinMask{headPin, leftTwo | leftThree, rightThree} {
+				complete, err := game.RollPins(0, knocked)
+				require.NoError(t, err)
+				completes = append(completes, complete)
+			}
+
+			assert.Equal(t, []bool{false, false, true}, completes)
+			frame := game.GetPlayers()[0].frames[0]
+			assert.Equal(t, []int{5, 5, 3}, frame.GetPins())
+			assert.Equal(t, []PinMask{headPin, leftTwo | leftThree, rightThree}, frame.GetPinMasks())
+		})
+
+		t.Run("should_reject_pins_already_down", func(t *testing.T) {
+			game := &FivePinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			_, err := game.RollPins(0, headPin)
+			require.NoError(t, err)
+
+			_, err = game.RollPins(0, headPin)
+
+			assert.Error(t, err)
+			assert.Equal(t, []int{5}, game.GetPlayers()[0].frames[0].GetPins(), "invalid roll should not be added")
+		})
+
+		t.Run("should_roll_fill_balls_in_last_frame", func(t *testing.T) {
+			game := &FivePinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			game.players[0].currentFrame = 9
+
+			var completes []bool
+			for _, knocked := range []PinMask{allFivePins, headPin, allFivePins &^ headPin} {
+				complete, err := game.RollPins(0, knocked)
+				require.NoError(t, err)
+				completes = append(completes, complete)
+			}
+
+			assert.Equal(t, []bool{false, false, true}, completes)
+			assert.Equal(t, []int{30}, game.GetPlayers()[0].GetScores()[9:])
+		})
+	})
+
+	t.Run("CorrectFramePins", func(t *testing.T) {
+		t.Run("should_correct_with_knocked_pins", func(t *testing.T) {
+			game := &FivePinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			require.NoError(t, game.SetFramePins(0, headPin, leftTwo, 0))
+
+			assert.Error(t, game.CorrectFrame(0, 0, 5, 2, 0), "knocked pins must be specified")
+			require.NoError(t, game.CorrectFramePins(0, 0, headPin, leftTwo|leftThree, rightTwo))
+
+			assert.Equal(t, []int{5, 5, 2}, game.GetPlayers()[0].frames[0].GetPins())
+		})
+	})
+
+	t.Run("Fouls", func(t *testing.T) {
+		t.Run("should_record_foul_with_knocked_pins", func(t *testing.T) {
+			game := &FivePinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			err := game.SetFramePins(0, FoulMask, allFivePins)
+
+			assert.NoError(t, err)
+			frame := game.GetPlayers()[0].frames[0]
+			assert.Equal(t, []int{0, 15}, frame.GetPins())
+			assert.Equal(t, []PinMask{0, allFivePins}, frame.GetPinMasks())
+			assert.Equal(t, []int{0}, frame.GetFouls())
+			assert.True(t, frame.(*normalFrame).isSpare(), "all pins with the second ball after a foul is a spare")
+		})
+
+		t.Run("should_record_foul_roll_by_roll", func(t *testing.T) {
+			game := &FivePinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			_, err := game.RollPins(0, headPin)
+			require.NoError(t, err)
+			_, err = game.Roll(0, Foul)
+			require.NoError(t, err)
+			complete, err := game.RollPins(0, FoulMask)
+			require.NoError(t, err)
+
+			assert.True(t, complete)
+			frame := game.GetPlayers()[0].frames[0]
+			assert.Equal(t, []int{5, 0, 0}, frame.GetPins())
+			assert.Equal(t, []PinMask{headPin, 0, 0}, frame.GetPinMasks())
+			assert.Equal(t, []int{1, 2}, frame.GetFouls())
+		})
+
+		t.Run("should_record_foul_with_leaves", func(t *testing.T) {
+			game := &FivePinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			err := game.SetFrameLeaves(0, allFivePins&^headPin, FoulMask, 0)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []int{5, 0, 10}, game.GetPlayers()[0].frames[0].GetPins())
+			assert.Equal(t, []int{1}, game.GetPlayers()[0].frames[0].GetFouls())
+		})
+	})
+
+	t.Run("GetScores", func(t *testing.T) {
+		t.Run("perfect_game", func(t *testing.T) {
+			player := newPlayer("max", &fivePinRules)
+			for i := 0; i < 9; i++ {
+				require.NoError(t, player.frames[i].KnockPinMasks(allFivePins))
+			}
+			require.NoError(t, player.frames[9].KnockPinMasks(allFivePins, allFivePins, allFivePins))
+
+			expected := []int{45, 45, 45, 45, 45, 45, 45, 45, 45, 45}
+			assert.Equal(t, expected, player.GetScores())
+		})
+
+		t.Run("incomplete_game_with_strike_spare", func(t *testing.T) {
+			player := newPlayer("spare", &fivePinRules)
+			require.NoError(t, player.frames[0].KnockPinMasks(allFivePins))
+			require.NoError(t, player.frames[1].KnockPinMasks(headPin|leftThree, leftTwo|rightThree|rightTwo))
+			require.NoError(t, player.frames[2].KnockPinMasks(headPin, rightTwo, leftThree))
+
+			expected := []int{30, 20, 10, 0, 0, 0, 0, 0, 0, 0}
+			assert.Equal(t, expected, player.GetScores())
+		})
+	})
+}
@@ -0,0 +1,67 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+)
+
+// HandicapRule is the league rule which computes the handicap of a bowler, which is added to the scratch score
+type HandicapRule struct {
+	// Base and Percentage compute the handicap from the average of the bowler, eg 90% of 220 minus the average
+	Base       int `json:"base"`
+	Percentage int `json:"percentage"`
+	// Max caps the handicap computed from the average. 0 means no cap.
+	Max int `json:"max"`
+	// Fixed is the handicap of all bowlers per game. It is used instead of the average when set.
+	Fixed int `json:"fixed"`
+}
+
+// Validate checks that the rule gives a handicap
+func (h HandicapRule) Validate() error {
+	if h.Base < 0 || h.Max < 0 || h.Fixed < 0 {
+		return errors.New("handicap base, max and fixed handicap can't be negative")
+	}
+	if h.Percentage < 0 || h.Percentage > 100 {
+		return errors.New("handicap percentage must be between 0 and 100")
+	}
+	return nil
+}
+
+// handicap returns the handicap of a bowler per game.
+// The explicit handicap of the bowler has priority over the rule.
+func (h HandicapRule) handicap(player PlayerOptions) int {
+	if player.Handicap != nil {
+		return *player.Handicap
+	}
+	if h.Fixed > 0 {
+		return h.Fixed
+	}
+	if player.Average == nil {
+		return 0
+	}
+
+	res := max((h.Base-*player.Average)*h.Percentage/100, 0)
+	if h.Max > 0 {
+		res = min(res, h.Max)
+	}
+	return res
+}
+
+// PlayerOptions contains the optional settings of a player of a game
+type PlayerOptions struct {
+	// Average is the average score of the bowler, which the handicap is computed from
+	Average *int `json:"average,omitempty"`
+	// Handicap is the explicit handicap of the bowler, which is used instead of the handicap rule of the game
+	Handicap *int `json:"handicap,omitempty"`
+}
+
+// Validate checks the average and the handicap of a player of a game with a max score, eg 300 in 10-pin bowling
+func (p PlayerOptions) Validate(maxScore int) error {
+	if p.Average != nil && (*p.Average < 0 || *p.Average > maxScore) {
+		return fmt.Errorf("average must be between 0 and %d", maxScore)
+	}
+	if p.Handicap != nil && *p.Handicap < 0 {
+		return errors.New("handicap can't be negative")
+	}
+	return nil
+}
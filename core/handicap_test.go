@@ -0,0 +1,84 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"bowling-score-tracker/configs"
+)
+
+func TestHandicapRule(t *testing.T) {
+	rule := HandicapRule{Base: 220, Percentage: 90}
+
+	t.Run("should_compute_percentage_of_base_minus_average", func(t *testing.T) {
+		assert.Equal(t, 63, rule.handicap(PlayerOptions{Average: lo.ToPtr(150)}))
+		assert.Equal(t, 0, rule.handicap(PlayerOptions{Average: lo.ToPtr(230)}), "average above base")
+		assert.Equal(t, 0, rule.handicap(PlayerOptions{}), "no average")
+	})
+
+	t.Run("should_cap_handicap", func(t *testing.T) {
+		capped := HandicapRule{Base: 220, Percentage: 90, Max: 50}
+
+		assert.Equal(t, 50, capped.handicap(PlayerOptions{Average: lo.ToPtr(150)}))
+	})
+
+	t.Run("should_use_fixed_handicap", func(t *testing.T) {
+		fixed := HandicapRule{Base: 220, Percentage: 90, Fixed: 20}
+
+		assert.Equal(t, 20, fixed.handicap(PlayerOptions{Average: lo.ToPtr(150)}))
+	})
+
+	t.Run("should_prefer_explicit_handicap", func(t *testing.T) {
+		assert.Equal(t, 12, rule.handicap(PlayerOptions{Average: lo.ToPtr(150), Handicap: lo.ToPtr(12)}))
+	})
+
+	t.Run("should_reject_invalid_rule", func(t *testing.T) {
+		assert.Error(t, HandicapRule{Base: -1}.Validate())
+		assert.Error(t, HandicapRule{Percentage: 101}.Validate())
+		assert.NoError(t, rule.Validate())
+	})
+}
+
+func TestGameManager_Handicap(t *testing.T) {
+	t.Run("should_reject_invalid_player_options", func(t *testing.T) {
+		m := NewGameManager()
+
+		_, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{Players: []PlayerOptions{{Average: lo.ToPtr(301)}}})
+		assert.Error(t, err)
+		_, err = m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{Players: []PlayerOptions{{}, {}}})
+		assert.Error(t, err)
+	})
+
+	t.Run("should_bound_averages_by_perfect_score_of_game", func(t *testing.T) {
+		m := NewGameManager()
+
+		startGameRes, err := m.StartGame(configs.FivePin, []string{"hung", "thuy"}, GameOptions{
+			Players: []PlayerOptions{{Average: lo.ToPtr(320)}},
+		})
+		require.NoError(t, err)
+		_, err = m.MarkBlind(startGameRes.Id, 1, lo.ToPtr(450))
+		assert.NoError(t, err)
+		_, err = m.AddPlayer(startGameRes.Id, "lan", lo.ToPtr(451))
+		assert.Error(t, err, "a perfect 5-pin game is 450")
+	})
+
+	t.Run("should_return_scratch_and_handicap_totals", func(t *testing.T) {
+		m := NewGameManager()
+		startGameRes, err := m.StartGame(configs.TenPin, []string{"hung", "thuy", "lan"}, GameOptions{
+			HandicapRule: HandicapRule{Base: 220, Percentage: 90},
+			Players:      []PlayerOptions{{Average: lo.ToPtr(150)}, {Handicap: lo.ToPtr(30)}},
+		})
+		require.NoError(t, err)
+
+		res, err := m.SetFrameResult(startGameRes.Id, 0, 3, 4)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int{7, 63, 70}, []int{res.Players[0].TotalScore, res.Players[0].Handicap, res.Players[0].HandicapTotal})
+		assert.Equal(t, lo.ToPtr(150), res.Players[0].Average)
+		assert.Equal(t, []int{0, 30, 30}, []int{res.Players[1].TotalScore, res.Players[1].Handicap, res.Players[1].HandicapTotal})
+		assert.Equal(t, 0, res.Players[2].Handicap)
+	})
+}
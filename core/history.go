@@ -0,0 +1,241 @@
+package core
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/samber/lo"
+
+	"bowling-score-tracker/configs"
+)
+
+// gameOperation is an operation changing the state of a game, eg setting a frame result
+type gameOperation func(game Game) error
+
+// errUnchanged is returned by an operation which succeeds without changing the game,
+// eg moving to the next frame once the players are in their last frame, so it isn't recorded
+var errUnchanged = errors.New("game is unchanged")
+
+// historyEntry is an operation applied to a game
+type historyEntry struct {
+	op gameOperation
+	// correction is the audit entry of a privileged correction, which is nil for other operations.
+	// Only editors can undo or redo a correction.
+	correction *AuditEntry
+	// players contains the options of the players after the operation,
+	// which is nil for the operations which don't change them, eg setting a frame result
+	players []PlayerOptions
+}
+
+// gameHistory contains the operations applied to a game since it started, so they can be undone and redone.
+// A game is restored by replaying its operations on a new game.
+type gameHistory struct {
+	// newGame creates and starts a game with the settings of the original game
+	newGame func() (Game, error)
+	done    []historyEntry
+	undone  []historyEntry
+}
+
+// replay creates a new game with the operations applied in order
+func (h *gameHistory) replay(entries []historyEntry) (Game, error) {
+	game, err := h.newGame()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range entries {
+		if err = e.op(game); err != nil {
+			return nil, err
+		}
+	}
+	return game, nil
+}
+
+// apply applies an operation to a game, and records it in the history of the game.
+// Applying a new operation clears the operations which can be redone, unless it leaves the game unchanged.
+func (m *GameManager) apply(gameId int32, op gameOperation) (g GameInfo, err error) {
+	return m.applyEntry(gameId, historyEntry{op: op})
+}
+
+// applyEntry is the same as apply, but with the history entry of the operation
+func (m *GameManager) applyEntry(gameId int32, entry historyEntry) (g GameInfo, err error) {
+	game := m.GameById[gameId]
+	if game == nil {
+		return g, errors.New("invalid game id")
+	}
+
+	err = entry.op(game)
+	if errors.Is(err, errUnchanged) {
+		return m.toGameInfo(gameId, game), nil
+	}
+	if err != nil {
+		return g, err
+	}
+
+	if history := m.historyById[gameId]; history != nil {
+		history.done = append(history.done, entry)
+		history.undone = nil
+	}
+	return m.toGameInfo(gameId, game), nil
+}
+
+// options returns the options of a game, with the player options of its last operation changing them,
+// eg adding a late player
+func (m *GameManager) options(gameId int32) GameOptions {
+	opts := m.optionsById[gameId]
+	if history := m.historyById[gameId]; history != nil {
+		for _, e := range history.done {
+			if e.players != nil {
+				opts.Players = e.players
+			}
+		}
+	}
+	return opts
+}
+
+// Undo reverts the last operation of a game, eg a frame result entered for the wrong player or a frame advanced too early.
+// A correction can only be undone with UndoCorrection. A finished game can't be reopened once a roll-off
+// or the next game of its series is started from its result.
+func (m *GameManager) Undo(gameId int32) (g GameInfo, err error) {
+	return m.undo(gameId, "")
+}
+
+// UndoCorrection reverts the last operation of a game, which must be a correction,
+// and records the undo made by editor in the audit log of the game
+func (m *GameManager) UndoCorrection(gameId int32, editor string) (g GameInfo, err error) {
+	if editor == "" {
+		return g, errors.New("editor is empty")
+	}
+	return m.undo(gameId, editor)
+}
+
+// undo reverts the last operation of a game, which must be a correction if and only if editor is set
+func (m *GameManager) undo(gameId int32, editor string) (g GameInfo, err error) {
+	history := m.historyById[gameId]
+	if m.GameById[gameId] == nil || history == nil {
+		return g, errors.New("invalid game id")
+	}
+	if len(history.done) == 0 {
+		return g, errors.New("nothing to undo")
+	}
+
+	last := len(history.done) - 1
+	entry := history.done[last]
+	if err = checkCorrection(entry, editor); err != nil {
+		return g, err
+	}
+	oldResult := entry.correctedFrame(m.GameById[gameId])
+	game, err := history.replay(history.done[:last])
+	if err != nil {
+		return g, err
+	}
+	if game.GetStatus() == configs.InProgress && m.hasDependentGames(gameId) {
+		return g, errors.New("game can't be reopened, because a roll-off or the next game of its series depends on its result")
+	}
+
+	m.recordCorrection(gameId, entry, editor, configs.CorrectionUndone, oldResult, game)
+	history.undone = append(history.undone, entry)
+	history.done = history.done[:last]
+	m.GameById[gameId] = game
+	return m.toGameInfo(gameId, game), nil
+}
+
+// hasDependentGames returns whether games were started from the result of a game,
+// which are its roll-offs, the later roll-offs between the players it left tied if it is a roll-off,
+// and the next game of its series
+func (m *GameManager) hasDependentGames(gameId int32) bool {
+	if len(m.rollOffsByParentId[gameId]) > 0 {
+		return true
+	}
+	if r := m.rollOffById[gameId]; r != nil {
+		rollOffs := m.rollOffsByParentId[r.parentId]
+		later := rollOffs[slices.Index(rollOffs, r)+1:]
+		if lo.SomeBy(later, func(item *rollOff) bool {
+			return item.place >= r.place && item.place < r.place+len(r.playerIndexes)
+		}) {
+			return true
+		}
+	}
+	s := m.seriesByGameId[gameId]
+	return s != nil && s.gameIds[len(s.gameIds)-1] != gameId
+}
+
+// Redo applies the last undone operation of a game again.
+// A correction can only be redone with RedoCorrection.
+func (m *GameManager) Redo(gameId int32) (g GameInfo, err error) {
+	return m.redo(gameId, "")
+}
+
+// RedoCorrection applies the last undone operation of a game again, which must be a correction,
+// and records the redo made by editor in the audit log of the game
+func (m *GameManager) RedoCorrection(gameId int32, editor string) (g GameInfo, err error) {
+	if editor == "" {
+		return g, errors.New("editor is empty")
+	}
+	return m.redo(gameId, editor)
+}
+
+// redo applies the last undone operation of a game again, which must be a correction if and only if editor is set
+func (m *GameManager) redo(gameId int32, editor string) (g GameInfo, err error) {
+	history := m.historyById[gameId]
+	game := m.GameById[gameId]
+	if game == nil || history == nil {
+		return g, errors.New("invalid game id")
+	}
+	if len(history.undone) == 0 {
+		return g, errors.New("nothing to redo")
+	}
+
+	last := len(history.undone) - 1
+	entry := history.undone[last]
+	if err = checkCorrection(entry, editor); err != nil {
+		return g, err
+	}
+	oldResult := entry.correctedFrame(game)
+	if err = entry.op(game); err != nil {
+		return g, err
+	}
+
+	m.recordCorrection(gameId, entry, editor, configs.CorrectionRedone, oldResult, game)
+	history.done = append(history.done, entry)
+	history.undone = history.undone[:last]
+	return m.toGameInfo(gameId, game), nil
+}
+
+// checkCorrection returns an error unless the operation is a correction undone or redone by an editor,
+// or another operation undone or redone without editor
+func checkCorrection(entry historyEntry, editor string) error {
+	if entry.correction != nil && editor == "" {
+		return errors.New("the operation is a correction, which can only be undone or redone by an editor")
+	}
+	if entry.correction == nil && editor != "" {
+		return errors.New("the operation is not a correction")
+	}
+	return nil
+}
+
+// correctedFrame returns the result of the frame corrected by a correction in a game, which is empty for other operations
+func (e historyEntry) correctedFrame(game Game) frameResult {
+	if e.correction == nil {
+		return frameResult{}
+	}
+	return getFrameResult(game, e.correction.PlayerIndex, e.correction.FrameIndex)
+}
+
+// recordCorrection records in the audit log of a game that editor undid or redid a correction,
+// with the result of the corrected frame before and after in the game
+func (m *GameManager) recordCorrection(gameId int32, entry historyEntry, editor string, action configs.AuditAction, oldResult frameResult, game Game) {
+	if entry.correction == nil {
+		return
+	}
+
+	record := AuditEntry{
+		Time:        m.now(),
+		Editor:      editor,
+		Action:      action,
+		PlayerIndex: entry.correction.PlayerIndex,
+		FrameIndex:  entry.correction.FrameIndex,
+	}
+	record.setResults(oldResult, entry.correctedFrame(game))
+	m.auditLogById[gameId] = append(m.auditLogById[gameId], record)
+}
@@ -0,0 +1,300 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"bowling-score-tracker/configs"
+)
+
+func TestGameHistory(t *testing.T) {
+	t.Run("Undo", func(t *testing.T) {
+		t.Run("should_reject_invalid_game_id", func(t *testing.T) {
+			m := NewGameManager()
+
+			_, err := m.Undo(1)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("should_reject_when_there_is_nothing_to_undo", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+			require.NoError(t, err)
+
+			_, err = m.Undo(startGameRes.Id)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("should_revert_next_frame", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+			require.NoError(t, err)
+			_, err = m.SetFrameResult(startGameRes.Id, 0, 3, 4)
+			require.NoError(t, err)
+			_, err = m.NextFrame(startGameRes.Id)
+			require.NoError(t, err)
+
+			res, err := m.Undo(startGameRes.Id)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 0, res.CurrentFrame)
+			assert.Equal(t, []int{3, 4}, res.Players[0].Frames[0], "previous operations should be kept")
+		})
+
+		t.Run("should_revert_frame_result_of_wrong_player", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung", "thuy"}, GameOptions{})
+			require.NoError(t, err)
+			_, err = m.SetFrameResult(startGameRes.Id, 1, 10)
+			require.NoError(t, err)
+
+			res, err := m.Undo(startGameRes.Id)
+			require.NoError(t, err)
+			assert.Nil(t, res.Players[1].Frames[0])
+
+			res, err = m.SetFrameResult(startGameRes.Id, 0, 10)
+			assert.NoError(t, err)
+			assert.Equal(t, []int{10}, res.Players[0].Frames[0])
+		})
+
+		t.Run("should_reject_correction", func(t *testing.T) {
+			m, gameId := startCorrectedGame(t)
+
+			_, err := m.Undo(gameId)
+
+			assert.Error(t, err, "a correction can only be undone by an editor")
+			res, err := m.GetGame(gameId)
+			require.NoError(t, err)
+			assert.Equal(t, []int{10}, res.Players[0].Frames[0])
+		})
+
+		t.Run("should_not_record_failed_operation", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+			require.NoError(t, err)
+			_, err = m.SetFrameResult(startGameRes.Id, 0, 3, 4)
+			require.NoError(t, err)
+			_, err = m.SetFrameResult(startGameRes.Id, 0, 3, 9)
+			require.Error(t, err)
+
+			res, err := m.Undo(startGameRes.Id)
+
+			assert.NoError(t, err)
+			assert.Nil(t, res.Players[0].Frames[0], "undo should revert the last successful operation")
+		})
+
+		t.Run("should_not_record_next_frame_which_moves_nobody", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+			require.NoError(t, err)
+			playGame(t, m, startGameRes.Id, []int{4, 5})
+
+			nextFrameRes, err := m.NextFrame(startGameRes.Id)
+			require.NoError(t, err)
+			assert.Equal(t, 9, nextFrameRes.CurrentFrame)
+			res, err := m.Undo(startGameRes.Id)
+
+			require.NoError(t, err)
+			assert.Nil(t, res.Players[0].Frames[9], "undo should revert the last frame result")
+		})
+
+		t.Run("should_not_record_next_player_frame_which_moves_nobody", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung", "thuy"}, GameOptions{})
+			require.NoError(t, err)
+			for i := 0; i < tenPinRules.NumFrames; i++ {
+				_, err = m.NextPlayerFrame(startGameRes.Id, 0)
+				require.NoError(t, err)
+			}
+
+			res, err := m.Undo(startGameRes.Id)
+
+			require.NoError(t, err)
+			assert.Equal(t, 8, res.Players[0].CurrentFrame, "undo should revert the last move")
+		})
+	})
+
+	t.Run("Undo_of_finished_game", func(t *testing.T) {
+		t.Run("should_reject_reopening_game_with_roll_off", func(t *testing.T) {
+			m := NewGameManager()
+			gameId := startTiedGame(t, m, "hung", "thuy")
+			_, err := m.StartRollOff(gameId, 1, configs.OneBall)
+			require.NoError(t, err)
+
+			_, err = m.Undo(gameId)
+
+			assert.Error(t, err)
+			res, err := m.GetGame(gameId)
+			require.NoError(t, err)
+			assert.Equal(t, configs.Completed, res.Status)
+		})
+
+		t.Run("should_reject_reopening_roll_off_with_later_roll_off", func(t *testing.T) {
+			m := NewGameManager()
+			gameId := startTiedGame(t, m, "hung", "thuy", "lan")
+			rollOffRes, err := m.StartRollOff(gameId, 1, configs.OneBall)
+			require.NoError(t, err)
+			for i, pins := range []int{9, 9, 7} {
+				_, err = m.SetFrameResult(rollOffRes.Id, i, pins)
+				require.NoError(t, err)
+			}
+			// hung and thuy are still tied at place 1
+			_, err = m.StartRollOff(gameId, 1, configs.OneBall)
+			require.NoError(t, err)
+
+			_, err = m.Undo(rollOffRes.Id)
+
+			assert.Error(t, err)
+			res, err := m.GetGame(gameId)
+			require.NoError(t, err)
+			assert.Equal(t, []int{1, 1, 3}, lo.Map(res.Standings, func(item Standing, index int) int {
+				return item.Place
+			}))
+		})
+
+		t.Run("should_reject_reopening_game_with_next_series_game", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+			require.NoError(t, err)
+			playGame(t, m, startGameRes.Id, []int{4, 5})
+			nextGameRes, err := m.StartNextGame(startGameRes.Id)
+			require.NoError(t, err)
+
+			_, err = m.Undo(startGameRes.Id)
+			assert.Error(t, err)
+
+			playGame(t, m, nextGameRes.Id, []int{4, 5})
+			res, err := m.Undo(nextGameRes.Id)
+			assert.NoError(t, err, "the last game of the series can be reopened")
+			assert.Equal(t, configs.InProgress, res.Status)
+		})
+	})
+
+	t.Run("UndoCorrection", func(t *testing.T) {
+		t.Run("should_reject_empty_editor", func(t *testing.T) {
+			m, gameId := startCorrectedGame(t)
+
+			_, err := m.UndoCorrection(gameId, "")
+
+			assert.Error(t, err)
+		})
+
+		t.Run("should_reject_operation_other_than_correction", func(t *testing.T) {
+			m, gameId := startCorrectedGame(t)
+			_, err := m.NextFrame(gameId)
+			require.NoError(t, err)
+
+			_, err = m.UndoCorrection(gameId, "desk")
+
+			assert.Error(t, err)
+		})
+
+		t.Run("should_revert_correction_and_record_it_in_audit_log", func(t *testing.T) {
+			m, gameId := startCorrectedGame(t)
+
+			res, err := m.UndoCorrection(gameId, "desk")
+
+			require.NoError(t, err)
+			assert.Equal(t, []int{3, 4}, res.Players[0].Frames[0])
+			require.Len(t, res.AuditLog, 2)
+			assert.Equal(t, configs.CorrectionUndone, res.AuditLog[1].Action)
+			assert.Equal(t, "desk", res.AuditLog[1].Editor)
+			assert.Equal(t, []int{10}, res.AuditLog[1].OldPins)
+			assert.Equal(t, []int{3, 4}, res.AuditLog[1].NewPins)
+		})
+	})
+
+	t.Run("Redo", func(t *testing.T) {
+		t.Run("should_reject_when_there_is_nothing_to_redo", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+			require.NoError(t, err)
+
+			_, err = m.Redo(startGameRes.Id)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("should_apply_undone_operations_in_order", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+			require.NoError(t, err)
+			_, _, err = m.Roll(startGameRes.Id, 0, 3)
+			require.NoError(t, err)
+			_, _, err = m.Roll(startGameRes.Id, 0, 4)
+			require.NoError(t, err)
+			_, err = m.Undo(startGameRes.Id)
+			require.NoError(t, err)
+			_, err = m.Undo(startGameRes.Id)
+			require.NoError(t, err)
+
+			res, err := m.Redo(startGameRes.Id)
+			require.NoError(t, err)
+			assert.Equal(t, []int{3}, res.Players[0].Frames[0])
+
+			res, err = m.Redo(startGameRes.Id)
+			require.NoError(t, err)
+			assert.Equal(t, []int{3, 4}, res.Players[0].Frames[0])
+		})
+
+		t.Run("should_reject_correction", func(t *testing.T) {
+			m, gameId := startCorrectedGame(t)
+			_, err := m.UndoCorrection(gameId, "desk")
+			require.NoError(t, err)
+
+			_, err = m.Redo(gameId)
+
+			assert.Error(t, err, "a correction can only be redone by an editor")
+		})
+
+		t.Run("should_clear_redo_after_new_operation", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+			require.NoError(t, err)
+			_, err = m.NextFrame(startGameRes.Id)
+			require.NoError(t, err)
+			_, err = m.Undo(startGameRes.Id)
+			require.NoError(t, err)
+			_, err = m.SetFrameResult(startGameRes.Id, 0, 10)
+			require.NoError(t, err)
+
+			_, err = m.Redo(startGameRes.Id)
+
+			assert.Error(t, err)
+		})
+	})
+
+	t.Run("RedoCorrection", func(t *testing.T) {
+		t.Run("should_apply_correction_again_and_record_it_in_audit_log", func(t *testing.T) {
+			m, gameId := startCorrectedGame(t)
+			_, err := m.UndoCorrection(gameId, "desk")
+			require.NoError(t, err)
+
+			res, err := m.RedoCorrection(gameId, "admin")
+
+			require.NoError(t, err)
+			assert.Equal(t, []int{10}, res.Players[0].Frames[0])
+			require.Len(t, res.AuditLog, 3)
+			assert.Equal(t, configs.CorrectionRedone, res.AuditLog[2].Action)
+			assert.Equal(t, []int{3, 4}, res.AuditLog[2].OldPins)
+			assert.Equal(t, []int{10}, res.AuditLog[2].NewPins)
+		})
+	})
+}
+
+// startCorrectedGame starts a game where the first frame of the player is corrected from 3-4 to a strike
+func startCorrectedGame(t *testing.T) (*GameManager, int32) {
+	m := NewGameManager()
+	startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+	require.NoError(t, err)
+	_, err = m.SetFrameResult(startGameRes.Id, 0, 3, 4)
+	require.NoError(t, err)
+	_, err = m.CorrectFrame(startGameRes.Id, "admin", 0, 0, 10)
+	require.NoError(t, err)
+	return m, startGameRes.Id
+}
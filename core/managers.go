@@ -2,7 +2,10 @@ package core
 
 import (
 	"errors"
+	"fmt"
+	"slices"
 	"sync/atomic"
+	"time"
 
 	"github.com/samber/lo"
 
@@ -16,40 +19,183 @@ GameManager handles external requests, coordinate the domain objects and the dat
 */
 type GameManager struct {
 	GameById map[int32]Game
+	// auditLogById contains the corrections of each game
+	auditLogById map[int32][]AuditEntry
+	// historyById contains the operations of each game for undo and redo
+	historyById map[int32]*gameHistory
+	// optionsById contains the options each game was started with, eg its league rules.
+	// The player options changed by later operations are kept in the history of the game.
+	optionsById map[int32]GameOptions
+	// seriesByGameId contains the series of each game in a series
+	seriesByGameId map[int32]*series
+	// rollOffsByParentId contains the roll-offs started from each game, and rollOffById contains each roll-off game
+	rollOffsByParentId map[int32][]*rollOff
+	rollOffById        map[int32]*rollOff
+	now                func() time.Time
 }
 
 func NewGameManager() *GameManager {
-	return &GameManager{GameById: map[int32]Game{}}
+	return &GameManager{
+		GameById:           map[int32]Game{},
+		auditLogById:       map[int32][]AuditEntry{},
+		historyById:        map[int32]*gameHistory{},
+		optionsById:        map[int32]GameOptions{},
+		seriesByGameId:     map[int32]*series{},
+		rollOffsByParentId: map[int32][]*rollOff{},
+		rollOffById:        map[int32]*rollOff{},
+		now:                time.Now,
+	}
 }
 
 // GameInfo is the standard object used to communicate about the state of a game.
 type GameInfo struct {
-	Id           int32         `json:"id"`
-	CurrentFrame int           `json:"current_frame"`
-	Players      []PlayerScore `json:"players"`
+	Id            int32                 `json:"id"`
+	GameType      configs.GameType      `json:"game_type"`
+	ScoringSystem configs.ScoringSystem `json:"scoring_system"`
+	TapThreshold  int                   `json:"tap_threshold,omitempty"`
+	// CurrentFrame is the lowest frame in progress of all players
+	CurrentFrame int `json:"current_frame"`
+	// Status is IN_PROGRESS, COMPLETED once all players have completed their last frame, or ABANDONED
+	Status  configs.GameStatus `json:"status"`
+	Players []PlayerScore      `json:"players"`
+	// Teams contains the totals of the teams of a team game
+	Teams []TeamScore `json:"teams,omitempty"`
+	// Standings contains the final places of the players once the game is completed, including the result of roll-offs
+	Standings []Standing `json:"standings,omitempty"`
+	// RollOffs contains the roll-offs started to break the ties of the game
+	RollOffs []RollOffInfo `json:"roll_offs,omitempty"`
+	// ParentId is the id of the tied game of a roll-off
+	ParentId int32 `json:"parent_id,omitempty"`
+	// SeriesId is the id of the series of the game, which is the id of the first game of the series
+	SeriesId int32 `json:"series_id,omitempty"`
+	// SeriesTeams contains the totals of the teams over the games of the series of a team game
+	SeriesTeams []TeamSeries `json:"series_teams,omitempty"`
+	// AuditLog contains the corrections of previously entered frames in chronological order
+	AuditLog []AuditEntry `json:"audit_log,omitempty"`
 }
 
-func (m *GameManager) StartGame(t configs.GameType, playerNames []string) (g GameInfo, err error) {
-	var game Game
-	switch t {
-	case configs.TenPin:
-		game = &TenPinGame{}
-	default:
-		return g, errors.New("game type is not supported")
+// AuditEntry records a correction of a frame result, or the undo or redo of a correction
+type AuditEntry struct {
+	Time   time.Time `json:"time"`
+	Editor string    `json:"editor"`
+	// Action is CORRECTION, UNDO or REDO
+	Action      configs.AuditAction `json:"action"`
+	PlayerIndex int                 `json:"player_index"`
+	FrameIndex  int                 `json:"frame_index"`
+	OldPins     []int               `json:"old_pins"`
+	NewPins     []int               `json:"new_pins"`
+	// OldKnockedPins and NewKnockedPins contain the pins knocked by each roll of the frame,
+	// or nil if the frame is set with the numbers of pins
+	OldKnockedPins []PinMask `json:"old_knocked_pins,omitempty"`
+	NewKnockedPins []PinMask `json:"new_knocked_pins,omitempty"`
+	// OldFouls and NewFouls contain the indexes of the foul rolls of the frame
+	OldFouls []int `json:"old_fouls,omitempty"`
+	NewFouls []int `json:"new_fouls,omitempty"`
+}
+
+// setResults sets the results of the corrected frame before and after the correction
+func (e *AuditEntry) setResults(old frameResult, new frameResult) {
+	e.OldPins, e.OldKnockedPins, e.OldFouls = old.pins, old.knockedPins, old.fouls
+	e.NewPins, e.NewKnockedPins, e.NewFouls = new.pins, new.knockedPins, new.fouls
+}
+
+// GameOptions contains the optional settings of a game. Settings which don't apply to the game type are ignored.
+type GameOptions struct {
+	// TapThreshold is the number of pins with the first ball which counts as a strike in no-tap games.
+	// Default to 9.
+	TapThreshold int
+	// ScoringSystem is the scoring system of the game. Default to the traditional scoring system.
+	ScoringSystem configs.ScoringSystem
+	// Rules are the rules of a custom game
+	Rules *Rules
+	// BlindRule computes the score of the players marked blind
+	BlindRule BlindRule
+	// HandicapRule computes the handicap of the players
+	HandicapRule HandicapRule
+	// Players contains the options of the players, in the same order as the player names
+	Players []PlayerOptions
+	// Teams contains the teams of a team game, in the same order as the player names
+	Teams []Team
+	// BakerOrder contains the bowlers of each team of a Baker game in the order they bowl the frames,
+	// in the same order as the player names, which are the names of the teams
+	BakerOrder [][]string
+	// Partners contains the 2 partners of each pair of a Scotch doubles game, starting with the partner who leads off,
+	// in the same order as the player names, which are the names of the pairs
+	Partners [][]string
+}
+
+// validate checks the league rules and the player options of a game with a number of players,
+// which is played with the given rules
+func (o GameOptions) validate(numPlayers int, rules Rules) error {
+	if err := o.BlindRule.Validate(); err != nil {
+		return err
+	}
+	if err := o.HandicapRule.Validate(); err != nil {
+		return err
 	}
+	if len(o.Players) > numPlayers {
+		return errors.New("there are more player options than players")
+	}
+	maxScore := rules.perfectScore()
+	for _, e := range o.Players {
+		if err := e.Validate(maxScore); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	if err = game.StartGame(playerNames); err != nil {
+// player returns the options of a player, which are empty for the players without options, eg late players
+func (o GameOptions) player(playerIndex int) PlayerOptions {
+	if playerIndex < len(o.Players) {
+		return o.Players[playerIndex]
+	}
+	return PlayerOptions{}
+}
+
+func (m *GameManager) StartGame(t configs.GameType, playerNames []string, opts GameOptions) (g GameInfo, err error) {
+	gameType, ok := getGameType(t)
+	if !ok {
+		return g, errors.New("game type is not supported")
+	}
+	playerNames = slices.Clone(playerNames)
+	opts.Players = slices.Clone(opts.Players)
+	opts.Teams = slices.Clone(opts.Teams)
+	opts.BakerOrder = slices.Clone(opts.BakerOrder)
+	opts.Partners = slices.Clone(opts.Partners)
+	curId, game, err := m.addGame(func() (Game, error) {
+		game := gameType.factory(opts)
+		if err := game.StartGame(playerNames); err != nil {
+			return game, err
+		}
+		// the options depend on the rules of the started game, eg the averages are bounded by its perfect score
+		return game, opts.validate(len(playerNames), game.GetRules())
+	})
+	if err != nil {
 		return g, err
 	}
 
+	m.optionsById[curId] = opts
+	return m.toGameInfo(curId, game), nil
+}
+
+// addGame creates and starts a game with newGame, and stores it with a new id.
+// newGame is also used to restore the game when undoing its operations.
+func (m *GameManager) addGame(newGame func() (Game, error)) (int32, Game, error) {
+	game, err := newGame()
+	if err != nil {
+		return 0, nil, err
+	}
+
 	curId := id.Add(1)
 	m.GameById[curId] = game
+	m.historyById[curId] = &gameHistory{newGame: newGame}
+	return curId, game, nil
+}
 
-	return GameInfo{
-		Id:           curId,
-		CurrentFrame: game.GetCurrentFrame(),
-		Players:      lo.Map(game.GetPlayers(), playerToPlayerScore),
-	}, nil
+// GetGameTypes returns the metadata of all game types which can be started
+func (m *GameManager) GetGameTypes() []GameTypeInfo {
+	return GetGameTypes()
 }
 
 func (m *GameManager) GetGame(gameId int32) (g GameInfo, err error) {
@@ -58,63 +204,457 @@ func (m *GameManager) GetGame(gameId int32) (g GameInfo, err error) {
 		return g, errors.New("invalid game id")
 	}
 
-	return GameInfo{
-		Id:           gameId,
-		CurrentFrame: game.GetCurrentFrame(),
-		Players:      lo.Map(game.GetPlayers(), playerToPlayerScore),
-	}, nil
+	return m.toGameInfo(gameId, game), nil
 }
 
 type PlayerScore struct {
-	Name       string  `json:"name"`
-	Frames     [][]int `json:"frames"`
-	Scores     []int   `json:"scores"`
-	TotalScore int     `json:"total_score"`
+	// Name is the name of the current bowler of the player
+	Name string `json:"name"`
+	// Bowlers contains the name of the bowler of each frame, which is empty when nobody bowled the frame,
+	// eg before a late player joined or after a player withdrew
+	Bowlers   []string `json:"bowlers"`
+	Withdrawn bool     `json:"withdrawn,omitempty"`
+	// Blind is whether the player is absent and scored with the blind rule of the game instead of rolls.
+	// The total score of a blind counts in team totals, but a blind is not ranked in the standings.
+	Blind bool `json:"blind,omitempty"`
+	// CurrentFrame is the frame in progress of the player, which can be ahead of the other players
+	CurrentFrame int     `json:"current_frame"`
+	Frames       [][]int `json:"frames"`
+	// BowlerStats contains the statistics of each bowler of the player, eg the bowlers of a team in a Baker game.
+	// It is omitted when a single bowler bowled all frames.
+	BowlerStats []BowlerStats `json:"bowler_stats,omitempty"`
+	// Throwers contains the partner who threw each roll of all frames in Scotch doubles
+	Throwers [][]string `json:"throwers,omitempty"`
+	// PartnerStats contains the statistics of each partner in Scotch doubles
+	PartnerStats []PartnerStats `json:"partner_stats,omitempty"`
+	// Fouls contains the indexes of the foul rolls of all frames, which are marked F on scoresheets.
+	// It is omitted when there is no foul.
+	Fouls     [][]int `json:"fouls,omitempty"`
+	FoulCount int     `json:"foul_count"`
+	// KnockedPins contains the pins knocked by each roll of all frames as pin masks, where bit i is pin number i+1.
+	// It is omitted when all frames are set with the numbers of pins.
+	KnockedPins [][]PinMask `json:"knocked_pins,omitempty"`
+	// Leaves contains the pins left standing after each roll of all frames as pin masks
+	Leaves [][]PinMask `json:"leaves,omitempty"`
+	// Splits contains the splits and washouts of all frames
+	Splits [][]Split `json:"splits,omitempty"`
+	// SplitCount and SplitsConverted count the splits of all frames, without washouts
+	SplitCount      int `json:"split_count"`
+	SplitsConverted int `json:"splits_converted"`
+	// Scores contains the score of each frame so far, including the frames still waiting for their bonus balls
+	Scores []int `json:"scores"`
+	// Resolved contains whether the score of each frame is final.
+	// A frame is not resolved while it is in progress or waiting for its bonus balls, eg a strike in the current frame,
+	// and the frames a late player missed without blind score are never resolved.
+	Resolved []bool `json:"resolved"`
+	// CumulativeScores contains the running total of each frame as shown on scoresheets,
+	// which is null from the first frame which is not resolved, and for the frames a late player missed without blind score
+	CumulativeScores []*int `json:"cumulative_scores"`
+	// TotalScore is the scratch total score, without handicap
+	TotalScore int `json:"total_score"`
+	// Average is the average of the bowler set when starting the game
+	Average *int `json:"average,omitempty"`
+	// Handicap is the handicap of the player in the game, and HandicapTotal is TotalScore plus Handicap
+	Handicap      int `json:"handicap"`
+	HandicapTotal int `json:"handicap_total"`
+	// MaxScore is the max possible total score, which is when all remaining balls are strikes
+	MaxScore int `json:"max_score"`
+	// MinScore is the total score guaranteed, which is when all remaining balls miss
+	MinScore int `json:"min_score"`
+	// ProjectedScore is the total score at the current pace of the player, which is null until a frame is resolved
+	ProjectedScore *int `json:"projected_score"`
 }
 
 // SetFrameResult set the result of a player at a specific playerIndex in the current frame of a specific game.
 // @params pins contains the numbers of pins knocked by each roll.
 // Examples: strike: pins = [10], non-strike: pins = [3, 4], last frame spare: pins = [4,6,5]
 func (m *GameManager) SetFrameResult(gameId int32, playerIndex int, pins ...int) (g GameInfo, err error) {
+	return m.apply(gameId, func(game Game) error {
+		setter, err := as[frameSetter](game, "setting frame results")
+		if err != nil {
+			return err
+		}
+		return setter.SetFrameResult(playerIndex, pins...)
+	})
+}
+
+// SetFramePins is the same as SetFrameResult, but with the pins knocked by each roll instead of the number of pins
+func (m *GameManager) SetFramePins(gameId int32, playerIndex int, knocked ...PinMask) (g GameInfo, err error) {
+	return m.apply(gameId, func(game Game) error {
+		setter, err := as[frameSetter](game, "setting frame results")
+		if err != nil {
+			return err
+		}
+		return setter.SetFramePins(playerIndex, knocked...)
+	})
+}
+
+// SetFrameLeaves is the same as SetFramePins, but with the pins left standing after each roll.
+func (m *GameManager) SetFrameLeaves(gameId int32, playerIndex int, leaves ...PinMask) (g GameInfo, err error) {
+	return m.apply(gameId, func(game Game) error {
+		setter, err := as[frameSetter](game, "setting frame results")
+		if err != nil {
+			return err
+		}
+		return setter.SetFrameLeaves(playerIndex, leaves...)
+	})
+}
+
+// Roll appends a roll knocking a number of pins to the current frame of a player in a specific game,
+// and returns whether the frame is complete.
+func (m *GameManager) Roll(gameId int32, playerIndex int, pins int) (g GameInfo, frameComplete bool, err error) {
+	g, err = m.apply(gameId, func(game Game) (err error) {
+		r, err := as[roller](game, "rolling without bowler")
+		if err != nil {
+			return err
+		}
+		frameComplete, err = r.Roll(playerIndex, pins)
+		return err
+	})
+	return g, frameComplete, err
+}
+
+// RollPins is the same as Roll, but with the pins knocked by the roll instead of the number of pins
+func (m *GameManager) RollPins(gameId int32, playerIndex int, knocked PinMask) (g GameInfo, frameComplete bool, err error) {
+	g, err = m.apply(gameId, func(game Game) (err error) {
+		r, err := as[roller](game, "rolling without bowler")
+		if err != nil {
+			return err
+		}
+		frameComplete, err = r.RollPins(playerIndex, knocked)
+		return err
+	})
+	return g, frameComplete, err
+}
+
+// RollBy is the same as Roll, but checks that it is the turn of the bowler, eg the partner in Scotch doubles
+func (m *GameManager) RollBy(gameId int32, playerIndex int, bowler string, pins int) (g GameInfo, frameComplete bool, err error) {
+	g, err = m.apply(gameId, func(game Game) (err error) {
+		r, err := as[bowlerRoller](game, "rolling with bowler")
+		if err != nil {
+			return err
+		}
+		frameComplete, err = r.RollBy(playerIndex, bowler, pins)
+		return err
+	})
+	return g, frameComplete, err
+}
+
+// RollPinsBy is the same as RollBy, but with the pins knocked by the roll instead of the number of pins
+func (m *GameManager) RollPinsBy(gameId int32, playerIndex int, bowler string, knocked PinMask) (g GameInfo, frameComplete bool, err error) {
+	g, err = m.apply(gameId, func(game Game) (err error) {
+		r, err := as[bowlerRoller](game, "rolling with bowler")
+		if err != nil {
+			return err
+		}
+		frameComplete, err = r.RollPinsBy(playerIndex, bowler, knocked)
+		return err
+	})
+	return g, frameComplete, err
+}
+
+// CorrectFrame replaces the result of a previously entered frame of a player in a specific game,
+// and records the correction made by editor in the audit log of the game.
+func (m *GameManager) CorrectFrame(gameId int32, editor string, playerIndex int, frameIndex int, pins ...int) (g GameInfo, err error) {
+	return m.correctFrame(gameId, editor, playerIndex, frameIndex, func(game Game) error {
+		c, err := as[corrector](game, "correcting frames")
+		if err != nil {
+			return err
+		}
+		return c.CorrectFrame(playerIndex, frameIndex, pins...)
+	})
+}
+
+// CorrectFramePins is the same as CorrectFrame, but with the pins knocked by each roll instead of the number of pins
+func (m *GameManager) CorrectFramePins(gameId int32, editor string, playerIndex int, frameIndex int, knocked ...PinMask) (g GameInfo, err error) {
+	return m.correctFrame(gameId, editor, playerIndex, frameIndex, func(game Game) error {
+		c, err := as[corrector](game, "correcting frames")
+		if err != nil {
+			return err
+		}
+		return c.CorrectFramePins(playerIndex, frameIndex, knocked...)
+	})
+}
+
+// CorrectFrameLeaves is the same as CorrectFramePins, but with the pins left standing after each roll
+func (m *GameManager) CorrectFrameLeaves(gameId int32, editor string, playerIndex int, frameIndex int, leaves ...PinMask) (g GameInfo, err error) {
+	return m.correctFrame(gameId, editor, playerIndex, frameIndex, func(game Game) error {
+		c, err := as[corrector](game, "correcting frames")
+		if err != nil {
+			return err
+		}
+		return c.CorrectFrameLeaves(playerIndex, frameIndex, leaves...)
+	})
+}
+
+// correctFrame applies the correction of a frame of a player in a game,
+// and records the old and new results of the frame in the audit log of the game
+func (m *GameManager) correctFrame(gameId int32, editor string, playerIndex int, frameIndex int, op gameOperation) (g GameInfo, err error) {
 	game := m.GameById[gameId]
 	if game == nil {
 		return g, errors.New("invalid game id")
 	}
+	if editor == "" {
+		return g, errors.New("editor is empty")
+	}
 
-	if err = game.SetFrameResult(playerIndex, pins...); err != nil {
+	oldResult := getFrameResult(game, playerIndex, frameIndex)
+	correction := &AuditEntry{PlayerIndex: playerIndex, FrameIndex: frameIndex}
+	g, err = m.applyEntry(gameId, historyEntry{op: op, correction: correction})
+	if err != nil {
 		return g, err
 	}
 
-	return GameInfo{
-		Id:           gameId,
-		CurrentFrame: game.GetCurrentFrame(),
-		Players:      lo.Map(game.GetPlayers(), playerToPlayerScore),
-	}, nil
+	*correction = AuditEntry{
+		Time:        m.now(),
+		Editor:      editor,
+		Action:      configs.Correction,
+		PlayerIndex: playerIndex,
+		FrameIndex:  frameIndex,
+	}
+	correction.setResults(oldResult, getFrameResult(game, playerIndex, frameIndex))
+	m.auditLogById[gameId] = append(m.auditLogById[gameId], *correction)
+	g.AuditLog = m.auditLogById[gameId]
+	return g, nil
 }
 
-// NextFrame increases the current frame of a game
+// NextFrame moves the players at the lowest frame in progress of a game to their next frame
 func (m *GameManager) NextFrame(gameId int32) (g GameInfo, err error) {
+	return m.apply(gameId, func(game Game) error {
+		if lowest := game.GetCurrentFrame(); game.NextFrame() == lowest {
+			return errUnchanged
+		}
+		return nil
+	})
+}
+
+// AbandonGame stops a game in progress, eg when players leave before the end
+func (m *GameManager) AbandonGame(gameId int32) (g GameInfo, err error) {
+	return m.apply(gameId, func(game Game) error {
+		return game.Abandon()
+	})
+}
+
+// NextPlayerFrame moves a player of a game to their next frame, so players can bowl at different paces
+func (m *GameManager) NextPlayerFrame(gameId int32, playerIndex int) (g GameInfo, err error) {
+	return m.apply(gameId, func(game Game) error {
+		players := game.GetPlayers()
+		if playerIndex < 0 || playerIndex >= len(players) {
+			return errors.New("invalid player index")
+		}
+		current := players[playerIndex].GetCurrentFrame()
+		frame, err := game.NextPlayerFrame(playerIndex)
+		if err == nil && frame == current {
+			return errUnchanged
+		}
+		return err
+	})
+}
+
+// AddPlayer adds a late player at the current frame of a game, with the average of the bowler if it is known.
+// The earlier frames of the player are scored with the blind rule of the game, given the average,
+// or left empty if the rule needs an average which is not set.
+func (m *GameManager) AddPlayer(gameId int32, name string, average *int) (g GameInfo, err error) {
+	game := m.GameById[gameId]
+	if game == nil {
+		return g, errors.New("invalid game id")
+	}
+	opts := m.options(gameId)
+	rules := game.GetRules()
+	if err = (PlayerOptions{Average: average}).Validate(rules.perfectScore()); err != nil {
+		return g, err
+	}
+	var blindScore *int
+	if average != nil || opts.BlindRule.Score > 0 {
+		blindScore = lo.ToPtr(opts.BlindRule.blindScore(lo.FromPtr(average)))
+	}
+
+	// the options of the late player are stored at its index with the operation, eg for its handicap,
+	// so they are removed when the operation is undone
+	players := make([]PlayerOptions, len(game.GetPlayers())+1)
+	copy(players, opts.Players)
+	players[len(players)-1] = PlayerOptions{Average: average}
+	return m.applyEntry(gameId, historyEntry{
+		op: func(game Game) error {
+			editor, err := as[rosterEditor](game, "changing players")
+			if err != nil {
+				return err
+			}
+			return editor.AddPlayer(name, blindScore)
+		},
+		players: players,
+	})
+}
+
+// WithdrawPlayer stops a player of a game, whose remaining frames are not bowled
+func (m *GameManager) WithdrawPlayer(gameId int32, playerIndex int) (g GameInfo, err error) {
+	return m.apply(gameId, func(game Game) error {
+		editor, err := as[rosterEditor](game, "changing players")
+		if err != nil {
+			return err
+		}
+		return editor.WithdrawPlayer(playerIndex)
+	})
+}
+
+// SubstitutePlayer replaces the bowler of a player in a game from a frame on
+func (m *GameManager) SubstitutePlayer(gameId int32, playerIndex int, name string, fromFrame int) (g GameInfo, err error) {
+	return m.apply(gameId, func(game Game) error {
+		editor, err := as[rosterEditor](game, "changing players")
+		if err != nil {
+			return err
+		}
+		return editor.SubstitutePlayer(playerIndex, name, fromFrame)
+	})
+}
+
+// SubstitutePartner replaces a partner of a player in a game from a frame on, eg a partner of a pair in Scotch doubles
+func (m *GameManager) SubstitutePartner(gameId int32, playerIndex int, partner string, name string, fromFrame int) (g GameInfo, err error) {
+	return m.apply(gameId, func(game Game) error {
+		substituter, err := as[partnerSubstituter](game, "substituting partners")
+		if err != nil {
+			return err
+		}
+		return substituter.SubstitutePartner(playerIndex, partner, name, fromFrame)
+	})
+}
+
+// MarkBlind scores an absent player of a game with the blind rule of the game, given the average of the player.
+// The average set in the options of the player is used when average is nil.
+// It is only required when the rule doesn't have a fixed score.
+func (m *GameManager) MarkBlind(gameId int32, playerIndex int, average *int) (g GameInfo, err error) {
 	game := m.GameById[gameId]
 	if game == nil {
 		return g, errors.New("invalid game id")
 	}
+	opts := m.options(gameId)
+	if average == nil {
+		average = opts.player(playerIndex).Average
+	}
+	if average == nil && opts.BlindRule.Score == 0 {
+		return g, errors.New("average of the player is required by the blind rule")
+	}
+	rules := game.GetRules()
+	if err = (PlayerOptions{Average: average}).Validate(rules.perfectScore()); err != nil {
+		return g, err
+	}
+
+	score := opts.BlindRule.blindScore(lo.FromPtr(average))
+	return m.apply(gameId, func(game Game) error {
+		editor, err := as[rosterEditor](game, "changing players")
+		if err != nil {
+			return err
+		}
+		return editor.MarkBlind(playerIndex, score)
+	})
+}
+
+// as returns a game as the optional interface T of an operation, eg a roller to add a roll,
+// or an error if the game type doesn't support the operation
+func as[T any](game Game, operation string) (T, error) {
+	res, ok := game.(T)
+	if !ok {
+		return res, fmt.Errorf("%s is not supported in %s games", operation, game.GetGameType())
+	}
+	return res, nil
+}
+
+// frameResult is the result of a frame recorded in the audit log
+type frameResult struct {
+	pins        []int
+	knockedPins []PinMask
+	fouls       []int
+}
+
+// getFrameResult returns the result of a frame of a player, which is empty if the player or the frame doesn't exist
+func getFrameResult(game Game, playerIndex int, frameIndex int) frameResult {
+	players := game.GetPlayers()
+	if playerIndex < 0 || playerIndex >= len(players) {
+		return frameResult{}
+	}
+	frames := players[playerIndex].frames
+	if frameIndex < 0 || frameIndex >= len(frames) {
+		return frameResult{}
+	}
+	frame := frames[frameIndex]
+	return frameResult{pins: frame.GetPins(), knockedPins: frame.GetPinMasks(), fouls: frame.GetFouls()}
+}
 
-	game.NextFrame()
+func (m *GameManager) toGameInfo(gameId int32, game Game) GameInfo {
+	info := GameInfo{
+		Id:            gameId,
+		GameType:      game.GetGameType(),
+		ScoringSystem: game.GetScoringSystem(),
+		TapThreshold:  game.GetRules().TapThreshold,
+		CurrentFrame:  game.GetCurrentFrame(),
+		Status:        game.GetStatus(),
+		Standings:     m.getStandings(gameId, game),
+		RollOffs:      m.getRollOffs(gameId),
+		Players:       m.toPlayerScores(gameId, game),
+		AuditLog:      m.auditLogById[gameId],
+	}
+	info.Teams = getTeamScores(m.options(gameId).Teams, info.Players)
+	if r := m.rollOffById[gameId]; r != nil {
+		info.ParentId = r.parentId
+	}
+	if s := m.seriesByGameId[gameId]; s != nil {
+		info.SeriesId = s.id
+		info.SeriesTeams = m.toSeriesInfo(s).Teams
+	}
+	return info
+}
 
-	return GameInfo{
-		Id:           gameId,
-		CurrentFrame: game.GetCurrentFrame(),
-		Players:      lo.Map(game.GetPlayers(), playerToPlayerScore),
-	}, nil
+// toPlayerScores returns the scores of the players of a game, with their handicaps set with the options of the game
+func (m *GameManager) toPlayerScores(gameId int32, game Game) []PlayerScore {
+	res := lo.Map(game.GetPlayers(), playerToPlayerScore)
+	opts := m.options(gameId)
+	for i := range res {
+		player := opts.player(i)
+		res[i].Average = player.Average
+		res[i].Handicap = opts.HandicapRule.handicap(player)
+		res[i].HandicapTotal = res[i].TotalScore + res[i].Handicap
+	}
+	if tracker, ok := game.(partnerTracker); ok {
+		for i := range res {
+			res[i].Throwers = tracker.GetThrowers(i)
+			res[i].PartnerStats = tracker.GetPartnerStats(i)
+		}
+	}
+	return res
 }
 
 func playerToPlayerScore(p *Player, index int) PlayerScore {
-	return PlayerScore{
-		Name:   p.name,
-		Frames: p.GetFrameResults(),
-		Scores: p.GetScores(),
-		TotalScore: lo.Reduce(p.GetScores(), func(agg int, item int, index int) int {
-			return agg + item
-		}, 0),
+	res := PlayerScore{
+		Name:             p.name,
+		Bowlers:          p.GetBowlers(),
+		Withdrawn:        p.IsWithdrawn(),
+		Blind:            p.IsBlind(),
+		CurrentFrame:     p.GetCurrentFrame(),
+		Frames:           p.GetFrameResults(),
+		Fouls:            p.GetFrameFouls(),
+		KnockedPins:      p.GetFramePinMasks(),
+		Leaves:           p.GetFrameLeaves(),
+		Splits:           p.GetFrameSplits(),
+		Scores:           p.GetScores(),
+		Resolved:         p.GetResolvedFrames(),
+		CumulativeScores: p.GetCumulativeScores(),
+		TotalScore:       p.GetTotalScore(),
+		MaxScore:         p.GetMaxScore(),
+		MinScore:         p.GetMinScore(),
+		ProjectedScore:   p.GetProjectedScore(),
+	}
+	if stats := p.GetBowlerStats(); len(stats) > 1 {
+		res.BowlerStats = stats
+	}
+	res.FoulCount = len(lo.Flatten(res.Fouls))
+	for _, split := range lo.Flatten(res.Splits) {
+		if split.Washout {
+			continue
+		}
+		res.SplitCount++
+		if split.Converted {
+			res.SplitsConverted++
+		}
 	}
+	return res
 }
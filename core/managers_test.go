@@ -2,8 +2,11 @@ package core
 
 import (
 	"testing"
+	"time"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"bowling-score-tracker/configs"
 )
@@ -13,7 +16,7 @@ func TestGameManager(t *testing.T) {
 		t.Run("should_reject_invalid_game_type", func(t *testing.T) {
 			m := NewGameManager()
 
-			_, err := m.StartGame("abc", []string{"hung"})
+			_, err := m.StartGame("abc", []string{"hung"}, GameOptions{})
 
 			assert.Error(t, err)
 		})
@@ -22,18 +25,80 @@ func TestGameManager(t *testing.T) {
 			t.Run("should_return_error_when_failing_to_start_game", func(t *testing.T) {
 				m := NewGameManager()
 
-				_, err := m.StartGame(configs.TenPin, []string{""})
+				_, err := m.StartGame(configs.TenPin, []string{""}, GameOptions{})
 
 				assert.Error(t, err)
 			})
 			t.Run("should_return_success_when_starting_game_successfully", func(t *testing.T) {
 				m := NewGameManager()
 
-				startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"})
+				startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
 
 				assert.NoError(t, err)
 				assert.GreaterOrEqual(t, startGameRes.Id, int32(1))
 			})
+			t.Run("should_start_candlepin_game", func(t *testing.T) {
+				m := NewGameManager()
+
+				startGameRes, err := m.StartGame(configs.Candlepin, []string{"hung"}, GameOptions{})
+
+				assert.NoError(t, err)
+				assert.IsType(t, &CandlepinGame{}, m.GameById[startGameRes.Id])
+			})
+			t.Run("should_start_no_tap_game_with_tap_threshold", func(t *testing.T) {
+				m := NewGameManager()
+
+				startGameRes, err := m.StartGame(configs.TenPinNoTap, []string{"hung"}, GameOptions{TapThreshold: 8})
+
+				assert.NoError(t, err)
+				assert.Equal(t, configs.TenPinNoTap, startGameRes.GameType)
+				assert.Equal(t, 8, startGameRes.TapThreshold)
+			})
+			t.Run("should_start_game_with_current_frame_scoring", func(t *testing.T) {
+				m := NewGameManager()
+				startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{ScoringSystem: configs.CurrentFrame})
+				require.NoError(t, err)
+
+				res, err := m.SetFrameResult(startGameRes.Id, 0, 10)
+
+				assert.NoError(t, err)
+				assert.Equal(t, configs.CurrentFrame, res.ScoringSystem)
+				assert.Equal(t, 30, res.Players[0].TotalScore)
+			})
+			t.Run("should_start_custom_game_with_rules", func(t *testing.T) {
+				m := NewGameManager()
+				rules := tenPinRules
+				rules.NumFrames = 5
+
+				startGameRes, err := m.StartGame(configs.Custom, []string{"hung"}, GameOptions{Rules: &rules})
+
+				assert.NoError(t, err)
+				assert.Equal(t, configs.Custom, startGameRes.GameType)
+				assert.Len(t, startGameRes.Players[0].Scores, 5)
+			})
+			t.Run("should_reject_custom_game_without_rules", func(t *testing.T) {
+				m := NewGameManager()
+
+				_, err := m.StartGame(configs.Custom, []string{"hung"}, GameOptions{})
+
+				assert.Error(t, err)
+			})
+			t.Run("should_start_duckpin_game", func(t *testing.T) {
+				m := NewGameManager()
+
+				startGameRes, err := m.StartGame(configs.Duckpin, []string{"hung"}, GameOptions{})
+
+				assert.NoError(t, err)
+				assert.IsType(t, &DuckpinGame{}, m.GameById[startGameRes.Id])
+			})
+		})
+	})
+
+	t.Run("GetGameTypes", func(t *testing.T) {
+		t.Run("should_return_registered_game_types", func(t *testing.T) {
+			m := NewGameManager()
+
+			assert.Equal(t, GetGameTypes(), m.GetGameTypes())
 		})
 	})
 
@@ -48,21 +113,31 @@ func TestGameManager(t *testing.T) {
 
 		t.Run("should_return_game_info_when_game_id_is_valid", func(t *testing.T) {
 			m := NewGameManager()
-			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"})
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
 			m.SetFrameResult(startGameRes.Id, 0, 10)
 
 			res, err := m.GetGame(startGameRes.Id)
 
 			assert.NoError(t, err)
 			assert.Equal(t, GameInfo{
-				Id:           startGameRes.Id,
-				CurrentFrame: 0,
+				Id:            startGameRes.Id,
+				GameType:      configs.TenPin,
+				ScoringSystem: configs.Traditional,
+				CurrentFrame:  0,
+				Status:        configs.InProgress,
 				Players: []PlayerScore{
 					{
-						Name:       "hung",
-						Frames:     [][]int{{10}, nil, nil, nil, nil, nil, nil, nil, nil, nil},
-						Scores:     []int{10, 0, 0, 0, 0, 0, 0, 0, 0, 0},
-						TotalScore: 10,
+						Name:    "hung",
+						Bowlers: []string{"hung", "hung", "hung", "hung", "hung", "hung", "hung", "hung", "hung", "hung"},
+						Frames:  [][]int{{10}, nil, nil, nil, nil, nil, nil, nil, nil, nil},
+						Scores:  []int{10, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+						// the strike is waiting for its bonus balls
+						Resolved:         make([]bool, 10),
+						CumulativeScores: make([]*int, 10),
+						TotalScore:       10,
+						HandicapTotal:    10,
+						MaxScore:         300,
+						MinScore:         10,
 					},
 				},
 			}, res, "player score should reflect true score")
@@ -81,27 +156,37 @@ func TestGameManager(t *testing.T) {
 		t.Run("when_game_id_is_valid", func(t *testing.T) {
 			t.Run("should_return_error_when_failing_to_set_frame_result", func(t *testing.T) {
 				m := NewGameManager()
-				startGameRes, _ := m.StartGame(configs.TenPin, []string{"hung"})
+				startGameRes, _ := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
 
 				_, err := m.SetFrameResult(startGameRes.Id, 0, 1)
 				assert.Error(t, err)
 			})
 			t.Run("should_return_success_when_setting_frame_result_successfully", func(t *testing.T) {
 				m := NewGameManager()
-				startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"})
+				startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
 
 				res, err := m.SetFrameResult(startGameRes.Id, 0, 10)
 
 				assert.NoError(t, err)
 				assert.Equal(t, GameInfo{
-					Id:           startGameRes.Id,
-					CurrentFrame: 0,
+					Id:            startGameRes.Id,
+					GameType:      configs.TenPin,
+					ScoringSystem: configs.Traditional,
+					CurrentFrame:  0,
+					Status:        configs.InProgress,
 					Players: []PlayerScore{
 						{
-							Name:       "hung",
-							Frames:     [][]int{{10}, nil, nil, nil, nil, nil, nil, nil, nil, nil},
-							Scores:     []int{10, 0, 0, 0, 0, 0, 0, 0, 0, 0},
-							TotalScore: 10,
+							Name:    "hung",
+							Bowlers: []string{"hung", "hung", "hung", "hung", "hung", "hung", "hung", "hung", "hung", "hung"},
+							Frames:  [][]int{{10}, nil, nil, nil, nil, nil, nil, nil, nil, nil},
+							Scores:  []int{10, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+							// the strike is waiting for its bonus balls
+							Resolved:         make([]bool, 10),
+							CumulativeScores: make([]*int, 10),
+							TotalScore:       10,
+							HandicapTotal:    10,
+							MaxScore:         300,
+							MinScore:         10,
 						},
 					},
 				}, res, "player score should reflect true score")
@@ -109,6 +194,339 @@ func TestGameManager(t *testing.T) {
 		})
 	})
 
+	t.Run("SetFrameResult_with_fouls", func(t *testing.T) {
+		t.Run("should_count_fouls_of_player", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+			require.NoError(t, err)
+			_, err = m.SetFrameResult(startGameRes.Id, 0, Foul, 9)
+			require.NoError(t, err)
+			_, err = m.NextFrame(startGameRes.Id)
+			require.NoError(t, err)
+
+			res, err := m.SetFrameResult(startGameRes.Id, 0, 8, Foul)
+
+			assert.NoError(t, err)
+			assert.Equal(t, [][]int{{0}, {1}, nil, nil, nil, nil, nil, nil, nil, nil}, res.Players[0].Fouls)
+			assert.Equal(t, 2, res.Players[0].FoulCount)
+			assert.Equal(t, 17, res.Players[0].TotalScore)
+		})
+	})
+
+	t.Run("SetFramePins", func(t *testing.T) {
+		t.Run("should_reject_invalid_game_id", func(t *testing.T) {
+			m := NewGameManager()
+
+			_, err := m.SetFramePins(1, 0, 1)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("should_return_success_when_setting_frame_pins_successfully", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.FivePin, []string{"hung"}, GameOptions{})
+
+			res, err := m.SetFramePins(startGameRes.Id, 0, 0b00100, 0b00011, 0b11000)
+
+			assert.NoError(t, err)
+			assert.Equal(t, [][]int{{5, 5, 5}, nil, nil, nil, nil, nil, nil, nil, nil, nil}, res.Players[0].Frames)
+			assert.Equal(t, 15, res.Players[0].TotalScore)
+		})
+	})
+
+	t.Run("SetFrameLeaves", func(t *testing.T) {
+		t.Run("should_reject_invalid_game_id", func(t *testing.T) {
+			m := NewGameManager()
+
+			_, err := m.SetFrameLeaves(1, 0, 1)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("should_return_knocked_pins_and_leaves", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+			require.NoError(t, err)
+			sevenTenSplit := PinMask(1<<6 | 1<<9)
+
+			res, err := m.SetFrameLeaves(startGameRes.Id, 0, sevenTenSplit, sevenTenSplit)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []int{8, 0}, res.Players[0].Frames[0])
+			assert.Equal(t, []PinMask{0b0110111111, 0}, res.Players[0].KnockedPins[0])
+			assert.Equal(t, []PinMask{sevenTenSplit, sevenTenSplit}, res.Players[0].Leaves[0])
+			assert.Nil(t, res.Players[0].Leaves[1])
+			assert.Equal(t, []Split{{Roll: 0, Leave: sevenTenSplit}}, res.Players[0].Splits[0])
+			assert.Equal(t, 1, res.Players[0].SplitCount)
+			assert.Equal(t, 0, res.Players[0].SplitsConverted)
+		})
+	})
+
+	t.Run("Roll", func(t *testing.T) {
+		t.Run("should_reject_invalid_game_id", func(t *testing.T) {
+			m := NewGameManager()
+
+			_, _, err := m.Roll(1, 0, 1)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("should_report_whether_frame_is_complete", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+			require.NoError(t, err)
+
+			res, complete, err := m.Roll(startGameRes.Id, 0, 6)
+			require.NoError(t, err)
+			assert.False(t, complete)
+			assert.Equal(t, []int{6}, res.Players[0].Frames[0])
+
+			res, complete, err = m.Roll(startGameRes.Id, 0, 4)
+			require.NoError(t, err)
+			assert.True(t, complete)
+			assert.Equal(t, []int{6, 4}, res.Players[0].Frames[0])
+		})
+	})
+
+	t.Run("RollPins", func(t *testing.T) {
+		t.Run("should_reject_invalid_game_id", func(t *testing.T) {
+			m := NewGameManager()
+
+			_, _, err := m.RollPins(1, 0, 1)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("should_roll_knocked_pins_in_five_pin_game", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.FivePin, []string{"hung"}, GameOptions{})
+			require.NoError(t, err)
+
+			res, complete, err := m.RollPins(startGameRes.Id, 0, 0b00100)
+
+			require.NoError(t, err)
+			assert.False(t, complete)
+			assert.Equal(t, []int{5}, res.Players[0].Frames[0])
+			assert.Equal(t, []PinMask{0b00100}, res.Players[0].KnockedPins[0])
+		})
+	})
+
+	t.Run("AbandonGame", func(t *testing.T) {
+		t.Run("should_reject_invalid_game_id", func(t *testing.T) {
+			m := NewGameManager()
+
+			_, err := m.AbandonGame(1)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("should_abandon_game", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+			require.NoError(t, err)
+
+			res, err := m.AbandonGame(startGameRes.Id)
+
+			assert.NoError(t, err)
+			assert.Equal(t, configs.Abandoned, res.Status)
+		})
+	})
+
+	t.Run("NextPlayerFrame", func(t *testing.T) {
+		t.Run("should_reject_invalid_game_id", func(t *testing.T) {
+			m := NewGameManager()
+
+			_, err := m.NextPlayerFrame(1, 0)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("should_return_current_frame_of_each_player", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung", "thuy"}, GameOptions{})
+			require.NoError(t, err)
+
+			res, err := m.NextPlayerFrame(startGameRes.Id, 1)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 0, res.CurrentFrame)
+			assert.Equal(t, 0, res.Players[0].CurrentFrame)
+			assert.Equal(t, 1, res.Players[1].CurrentFrame)
+		})
+	})
+
+	t.Run("CorrectFrame", func(t *testing.T) {
+		t.Run("should_reject_invalid_game_id", func(t *testing.T) {
+			m := NewGameManager()
+
+			_, err := m.CorrectFrame(1, "admin", 0, 0, 3, 4)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("should_reject_empty_editor", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+			require.NoError(t, err)
+
+			_, err = m.CorrectFrame(startGameRes.Id, "", 0, 0, 3, 4)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("should_not_record_invalid_correction", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+			require.NoError(t, err)
+
+			_, err = m.CorrectFrame(startGameRes.Id, "admin", 0, 5, 3, 4)
+			assert.Error(t, err)
+
+			res, err := m.GetGame(startGameRes.Id)
+			require.NoError(t, err)
+			assert.Empty(t, res.AuditLog)
+		})
+
+		t.Run("should_not_record_correction_of_frame_missed_by_late_player", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+			require.NoError(t, err)
+			for i := 0; i < 3; i++ {
+				_, err = m.NextFrame(startGameRes.Id)
+				require.NoError(t, err)
+			}
+			_, err = m.AddPlayer(startGameRes.Id, "thuy", lo.ToPtr(200))
+			require.NoError(t, err)
+
+			_, err = m.CorrectFrame(startGameRes.Id, "desk", 1, 2, 10)
+			assert.Error(t, err)
+
+			res, err := m.GetGame(startGameRes.Id)
+			require.NoError(t, err)
+			assert.Empty(t, res.AuditLog)
+			assert.Equal(t, 20, res.Players[1].Scores[2])
+		})
+
+		t.Run("should_rescore_and_record_correction_in_audit_log", func(t *testing.T) {
+			m := NewGameManager()
+			now := time.Date(2024, 5, 1, 20, 0, 0, 0, time.UTC)
+			m.now = func() time.Time { return now }
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+			require.NoError(t, err)
+			_, err = m.SetFrameResult(startGameRes.Id, 0, 3, 4)
+			require.NoError(t, err)
+			_, err = m.NextFrame(startGameRes.Id)
+			require.NoError(t, err)
+			_, err = m.SetFrameResult(startGameRes.Id, 0, 5, 2)
+			require.NoError(t, err)
+
+			res, err := m.CorrectFrame(startGameRes.Id, "admin", 0, 0, 3, 7)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 15+7, res.Players[0].TotalScore)
+			assert.Equal(t, []AuditEntry{{
+				Time:        now,
+				Editor:      "admin",
+				Action:      configs.Correction,
+				PlayerIndex: 0,
+				FrameIndex:  0,
+				OldPins:     []int{3, 4},
+				NewPins:     []int{3, 7},
+			}}, res.AuditLog)
+		})
+
+		t.Run("should_record_fouls_when_pin_counts_are_unchanged", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+			require.NoError(t, err)
+			_, err = m.SetFrameResult(startGameRes.Id, 0, Foul, 5)
+			require.NoError(t, err)
+
+			res, err := m.CorrectFrame(startGameRes.Id, "admin", 0, 0, 0, 5)
+
+			require.NoError(t, err)
+			require.Len(t, res.AuditLog, 1)
+			assert.Equal(t, []int{0, 5}, res.AuditLog[0].OldPins)
+			assert.Equal(t, []int{0, 5}, res.AuditLog[0].NewPins)
+			assert.Equal(t, []int{0}, res.AuditLog[0].OldFouls)
+			assert.Empty(t, res.AuditLog[0].NewFouls)
+		})
+	})
+
+	t.Run("CorrectFramePins", func(t *testing.T) {
+		t.Run("should_correct_five_pin_frame_and_record_correction", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.FivePin, []string{"hung"}, GameOptions{})
+			require.NoError(t, err)
+			_, err = m.SetFramePins(startGameRes.Id, 0, 0b00100, 0b00001, 0)
+			require.NoError(t, err)
+
+			res, err := m.CorrectFramePins(startGameRes.Id, "admin", 0, 0, 0b00100, 0b00011, 0b10000)
+
+			require.NoError(t, err)
+			assert.Equal(t, []int{5, 5, 2}, res.Players[0].Frames[0])
+			assert.Equal(t, []PinMask{0b00100, 0b00011, 0b10000}, res.Players[0].KnockedPins[0])
+			require.Len(t, res.AuditLog, 1)
+			assert.Equal(t, []int{5, 2, 0}, res.AuditLog[0].OldPins)
+			assert.Equal(t, []int{5, 5, 2}, res.AuditLog[0].NewPins)
+		})
+	})
+
+	t.Run("CorrectFrameLeaves", func(t *testing.T) {
+		t.Run("should_reject_empty_editor", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+			require.NoError(t, err)
+
+			_, err = m.CorrectFrameLeaves(startGameRes.Id, "", 0, 0, pins(7, 10), 0)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("should_correct_frame_with_leaves", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+			require.NoError(t, err)
+			_, err = m.SetFrameResult(startGameRes.Id, 0, 3, 4)
+			require.NoError(t, err)
+
+			res, err := m.CorrectFrameLeaves(startGameRes.Id, "admin", 0, 0, pins(7, 10), 0)
+
+			require.NoError(t, err)
+			assert.Equal(t, []int{8, 2}, res.Players[0].Frames[0])
+			assert.Equal(t, 1, res.Players[0].SplitsConverted)
+			require.Len(t, res.AuditLog, 1)
+			assert.Equal(t, []int{8, 2}, res.AuditLog[0].NewPins)
+		})
+
+		t.Run("should_record_knocked_pins_when_pin_counts_are_unchanged", func(t *testing.T) {
+			m := NewGameManager()
+			startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+			require.NoError(t, err)
+			setRes, err := m.SetFrameLeaves(startGameRes.Id, 0, pins(7, 10), pins(7))
+			require.NoError(t, err)
+			oldKnocked := setRes.Players[0].KnockedPins[0]
+
+			res, err := m.CorrectFrameLeaves(startGameRes.Id, "admin", 0, 0, pins(4, 10), pins(4))
+
+			require.NoError(t, err)
+			newKnocked := res.Players[0].KnockedPins[0]
+			assert.NotEqual(t, oldKnocked, newKnocked)
+			require.Len(t, res.AuditLog, 1)
+			assert.Equal(t, []int{8, 1}, res.AuditLog[0].OldPins)
+			assert.Equal(t, []int{8, 1}, res.AuditLog[0].NewPins)
+			assert.Equal(t, oldKnocked, res.AuditLog[0].OldKnockedPins)
+			assert.Equal(t, newKnocked, res.AuditLog[0].NewKnockedPins)
+
+			res, err = m.UndoCorrection(startGameRes.Id, "desk")
+
+			require.NoError(t, err)
+			require.Len(t, res.AuditLog, 2)
+			assert.Equal(t, newKnocked, res.AuditLog[1].OldKnockedPins)
+			assert.Equal(t, oldKnocked, res.AuditLog[1].NewKnockedPins)
+		})
+	})
+
 	t.Run("NextFrame", func(t *testing.T) {
 		t.Run("should_reject_invalid_game_id", func(t *testing.T) {
 			m := NewGameManager()
@@ -121,7 +539,7 @@ func TestGameManager(t *testing.T) {
 		t.Run("when_game_id_is_valid", func(t *testing.T) {
 			t.Run("should_increment_frame", func(t *testing.T) {
 				m := NewGameManager()
-				startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"})
+				startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
 
 				res, err := m.NextFrame(startGameRes.Id)
 
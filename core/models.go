@@ -5,88 +5,556 @@ package core
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"bowling-score-tracker/configs"
 )
 
 // Game interface is the standard interface for all bowling games.
+// The ways of entering and changing the results of a game depend on the game type,
+// so they are in separate interfaces which a game implements if it supports them, eg frameSetter or roller.
 type Game interface {
+	GetGameType() configs.GameType
+	GetScoringSystem() configs.ScoringSystem
+	// GetRules returns the rules the game is played with
+	GetRules() Rules
 	StartGame(playerNames []string) error
+	// NextFrame moves the players at the lowest frame in progress to their next frame, and returns the new lowest frame.
+	// No player moves once they are all in the last frame.
 	NextFrame() int
+	// NextPlayerFrame moves a player to their next frame, and returns their current frame.
+	// The player doesn't move once they are in the last frame.
+	NextPlayerFrame(playerIndex int) (int, error)
+	// GetCurrentFrame returns the lowest frame in progress of all players
 	GetCurrentFrame() int
+	// IsFinished returns whether all players have completed their last frame
+	IsFinished() bool
+	// GetStatus returns the status of the game, which is completed once all players have completed their last frame.
+	// Frame results can only be set while the game is in progress.
+	GetStatus() configs.GameStatus
+	// Abandon stops a game in progress
+	Abandon() error
+	// GetStandings returns the places of the players, which are only known once the game is completed
+	GetStandings() []Standing
 	GetPlayers() []*Player
-	// SetFrameResult set the result of a player at a specific playerIndex in the current frame of the game
+}
+
+// frameSetter is implemented by games where the result of a whole frame can be set at once
+type frameSetter interface {
+	// SetFrameResult set the result of a player at a specific playerIndex in the current frame of the player
 	// @params pins contains the numbers of pins knocked by each roll.
 	// Examples: strike: pins = [10], non-strike: pins = [3, 4], last frame spare: pins = [4,6,5]
 	SetFrameResult(playerIndex int, pins ...int) error
+	// SetFramePins is the same as SetFrameResult, but with the pins knocked by each roll instead of the number of pins.
+	// It is required by games where pins have different values, eg 5-pin bowling.
+	SetFramePins(playerIndex int, knocked ...PinMask) error
+	// SetFrameLeaves is the same as SetFramePins, but with the pins left standing after each roll.
+	SetFrameLeaves(playerIndex int, leaves ...PinMask) error
+}
+
+// roller is implemented by games where rolls can be added without their bowler
+type roller interface {
+	// Roll appends a roll knocking a number of pins to the current frame of a player,
+	// and returns whether the frame is complete
+	Roll(playerIndex int, pins int) (bool, error)
+	// RollPins is the same as Roll, but with the pins knocked by the roll instead of the number of pins
+	RollPins(playerIndex int, knocked PinMask) (bool, error)
+}
+
+// bowlerRoller is implemented by games where rolls can be added with their bowler
+type bowlerRoller interface {
+	// RollBy is the same as Roll, but checks that it is the turn of the bowler,
+	// who is the bowler of the frame, or the partner whose turn it is in Scotch doubles
+	RollBy(playerIndex int, bowler string, pins int) (bool, error)
+	// RollPinsBy is the same as RollBy, but with the pins knocked by the roll instead of the number of pins
+	RollPinsBy(playerIndex int, bowler string, knocked PinMask) (bool, error)
+}
+
+// corrector is implemented by games where previously entered frames can be corrected
+type corrector interface {
+	// CorrectFrame replaces the result of a frame which a player has already reached.
+	// The following frames are rescored with the corrected result.
+	CorrectFrame(playerIndex int, frameIndex int, pins ...int) error
+	// CorrectFramePins is the same as CorrectFrame, but with the pins knocked by each roll instead of the number of pins
+	CorrectFramePins(playerIndex int, frameIndex int, knocked ...PinMask) error
+	// CorrectFrameLeaves is the same as CorrectFramePins, but with the pins left standing after each roll
+	CorrectFrameLeaves(playerIndex int, frameIndex int, leaves ...PinMask) error
+}
+
+// partnerSubstituter is implemented by games where a player has several partners who can be substituted,
+// eg a pair in Scotch doubles
+type partnerSubstituter interface {
+	// SubstitutePartner replaces a partner of a player from a frame on
+	SubstitutePartner(playerIndex int, partner string, name string, fromFrame int) error
+}
+
+// partnerTracker is implemented by games where the partners of a player take turns throwing the balls,
+// eg a pair in Scotch doubles
+type partnerTracker interface {
+	// GetThrowers returns the partner who threw each ball of each frame of a player
+	GetThrowers(playerIndex int) [][]string
+	// GetPartnerStats returns the statistics of each partner of a player
+	GetPartnerStats(playerIndex int) []PartnerStats
+}
+
+// rosterEditor is implemented by games where players can change during the game
+type rosterEditor interface {
+	// AddPlayer adds a late player at the current frame of the game. The earlier frames of the player are not rolled,
+	// and are left empty, or scored with their share of blindScore if it is set, which is the blind score of a whole game.
+	AddPlayer(name string, blindScore *int) error
+	// WithdrawPlayer stops a player, whose remaining frames are not bowled
+	WithdrawPlayer(playerIndex int) error
+	// SubstitutePlayer replaces the bowler of a player from a frame on
+	SubstitutePlayer(playerIndex int, name string, fromFrame int) error
+	// MarkBlind scores an absent player with a blind score instead of rolls
+	MarkBlind(playerIndex int, score int) error
 }
 
 const numPin = 10
 const maxPlayer = 5
 
-// TenPinGame implements the rule of 10-pin bowling
+// baseGame contains the frame-control flow shared by all games
+// (starting, moving to the next frame, rolling with the bowler, correcting and changing players),
+// which play with different rules.
+type baseGame struct {
+	players []*Player
+	// scoring is the scoring system of the game. Default to the traditional scoring system.
+	scoring configs.ScoringSystem
+	// rules is set when the game starts
+	rules     *Rules
+	abandoned bool
+	// teams contains the teams of a team game, which is empty in other games
+	teams []Team
+}
+
+// newBaseGame creates the base of a game with the options which apply to all game types
+func newBaseGame(opts GameOptions) baseGame {
+	return baseGame{scoring: opts.ScoringSystem, teams: opts.Teams}
+}
+
+// TenPinGame implements the rule of 10-pin bowling, where frame results can be set at once or roll by roll.
+// It is also the base of the other games which are entered the same way, and embed it to play with different rules.
 type TenPinGame struct {
-	players      []*Player
-	currentFrame int
+	baseGame
+}
+
+// newTenPinGame creates a 10-pin game with the options which apply to all game types
+func newTenPinGame(opts GameOptions) TenPinGame {
+	return TenPinGame{baseGame: newBaseGame(opts)}
+}
+
+func (t *TenPinGame) GetGameType() configs.GameType {
+	return configs.TenPin
+}
+
+func (g *baseGame) GetScoringSystem() configs.ScoringSystem {
+	if g.scoring == "" {
+		return configs.Traditional
+	}
+	return g.scoring
+}
+
+func (g *baseGame) GetRules() Rules {
+	if g.rules == nil {
+		return tenPinRules
+	}
+	return *g.rules
 }
 
 func (t *TenPinGame) StartGame(playerNames []string) error {
+	return t.startGame(playerNames, tenPinRules)
+}
+
+// startGame creates the players of a game played with the given rules and the scoring system of the game
+func (g *baseGame) startGame(playerNames []string, rules Rules) error {
+	rules.ScoringSystem = g.GetScoringSystem()
+	if err := rules.Validate(); err != nil {
+		return err
+	}
+	if err := validateTeams(g.teams, len(playerNames), rules.MaxPlayers); err != nil {
+		return err
+	}
+	// each team has up to the max num of players of the game type, eg 2 teams of 5 players on a lane pair
+	rules.MaxPlayers *= max(len(g.teams), 1)
+
+	players, err := newPlayers(playerNames, &rules)
+	if err != nil {
+		return err
+	}
+
+	g.rules = &rules
+	g.players = players
+	return nil
+}
+
+// newPlayers validates the player names and creates the players of a game played with the given rules
+func newPlayers(playerNames []string, rules *Rules) ([]*Player, error) {
 	if len(playerNames) == 0 {
-		return errors.New("names is empty")
+		return nil, errors.New("names is empty")
 	}
-	if len(playerNames) > maxPlayer {
-		return fmt.Errorf("max num of players is %d", maxPlayer)
+	if len(playerNames) > rules.MaxPlayers {
+		return nil, fmt.Errorf("max num of players is %d", rules.MaxPlayers)
 	}
 
+	var players []*Player
 	for i, e := range playerNames {
 		if e == "" {
-			return fmt.Errorf("player at index %d has empty name", i)
+			return nil, fmt.Errorf("player at index %d has empty name", i)
 		}
-		t.players = append(t.players, NewPlayer(e))
+		players = append(players, newPlayer(e, rules))
 	}
+	return players, nil
+}
+
+func (g *baseGame) GetPlayers() []*Player {
+	return g.players
+}
+
+func (g *baseGame) GetCurrentFrame() int {
+	if len(g.players) == 0 {
+		return 0
+	}
+
+	// withdrawn and blind players don't hold the game back, unless no player is still bowling
+	active := lo.Filter(g.players, func(item *Player, index int) bool {
+		return item.isActive()
+	})
+	if len(active) == 0 {
+		active = g.players
+	}
+	res := active[0].currentFrame
+	for _, e := range active[1:] {
+		res = min(res, e.currentFrame)
+	}
+	return res
+}
+
+func (g *baseGame) NextFrame() int {
+	lowest := g.GetCurrentFrame()
+	if g.GetStatus() != configs.InProgress {
+		return lowest
+	}
+
+	for _, e := range g.players {
+		if e.currentFrame == lowest && e.isActive() {
+			e.nextFrame()
+		}
+	}
+	return g.GetCurrentFrame()
+}
+
+func (g *baseGame) NextPlayerFrame(playerIndex int) (int, error) {
+	if err := g.checkInProgress(); err != nil {
+		return 0, err
+	}
+	player, err := g.getActivePlayer(playerIndex)
+	if err != nil {
+		return 0, err
+	}
+
+	return player.nextFrame(), nil
+}
+
+// IsFinished returns whether all players are done, and at least one of them has completed the last frame
+func (g *baseGame) IsFinished() bool {
+	return lo.EveryBy(g.players, (*Player).IsDone) && lo.SomeBy(g.players, (*Player).hasCompletedLastFrame)
+}
+
+// GetStatus returns the status of the game, which is abandoned once no player is left to bowl it
+func (g *baseGame) GetStatus() configs.GameStatus {
+	if g.abandoned {
+		return configs.Abandoned
+	}
+	if g.IsFinished() {
+		return configs.Completed
+	}
+	if len(g.players) > 0 && !lo.SomeBy(g.players, (*Player).isActive) {
+		return configs.Abandoned
+	}
+	return configs.InProgress
+}
+
+func (g *baseGame) Abandon() error {
+	if err := g.checkInProgress(); err != nil {
+		return err
+	}
+
+	g.abandoned = true
 	return nil
 }
 
-func (t *TenPinGame) GetPlayers() []*Player {
-	return t.players
+func (g *baseGame) GetStandings() []Standing {
+	if g.GetStatus() != configs.Completed {
+		return nil
+	}
+	return getStandings(g.players)
 }
 
-func (t *TenPinGame) GetCurrentFrame() int {
-	return t.currentFrame
+// checkInProgress returns an error if the game is completed or abandoned
+func (g *baseGame) checkInProgress() error {
+	if status := g.GetStatus(); status != configs.InProgress {
+		return fmt.Errorf("game is %s", strings.ToLower(string(status)))
+	}
+	return nil
 }
 
-func (t *TenPinGame) NextFrame() int {
-	if t.currentFrame >= 9 {
-		return t.currentFrame
+func (g *baseGame) getPlayer(playerIndex int) (*Player, error) {
+	if playerIndex < 0 || playerIndex >= len(g.players) {
+		return nil, errors.New("invalid player index")
 	}
+	return g.players[playerIndex], nil
+}
 
-	t.currentFrame++
-	return t.currentFrame
+// getActivePlayer is the same as getPlayer, but returns an error if the player has withdrawn or is blind
+func (g *baseGame) getActivePlayer(playerIndex int) (*Player, error) {
+	player, err := g.getPlayer(playerIndex)
+	if err != nil {
+		return nil, err
+	}
+	if player.withdrawn {
+		return nil, errors.New("player has withdrawn")
+	}
+	if player.IsBlind() {
+		return nil, errors.New("player is blind")
+	}
+	return player, nil
 }
 
 func (t *TenPinGame) SetFrameResult(playerIndex int, pins ...int) error {
-	if playerIndex < 0 || playerIndex >= len(t.players) {
-		return errors.New("invalid player index")
+	if err := t.checkInProgress(); err != nil {
+		return err
+	}
+	player, err := t.getActivePlayer(playerIndex)
+	if err != nil {
+		return err
 	}
 
-	return t.players[playerIndex].frames[t.currentFrame].KnockPins(pins...)
+	return player.frameInProgress().KnockPins(pins...)
+}
+
+func (t *TenPinGame) SetFramePins(playerIndex int, knocked ...PinMask) error {
+	if err := t.checkInProgress(); err != nil {
+		return err
+	}
+	player, err := t.getActivePlayer(playerIndex)
+	if err != nil {
+		return err
+	}
+
+	return player.frameInProgress().KnockPinMasks(knocked...)
+}
+
+func (t *TenPinGame) SetFrameLeaves(playerIndex int, leaves ...PinMask) error {
+	rules := t.GetRules()
+	knocked, err := rules.masksFromLeaves(leaves)
+	if err != nil {
+		return err
+	}
+
+	return t.SetFramePins(playerIndex, knocked...)
+}
+
+func (t *TenPinGame) Roll(playerIndex int, pins int) (bool, error) {
+	if err := t.checkInProgress(); err != nil {
+		return false, err
+	}
+	player, err := t.getActivePlayer(playerIndex)
+	if err != nil {
+		return false, err
+	}
+
+	frame := player.frameInProgress()
+	if err = frame.Roll(pins); err != nil {
+		return false, err
+	}
+	return frame.IsComplete(), nil
+}
+
+func (t *TenPinGame) RollPins(playerIndex int, knocked PinMask) (bool, error) {
+	if err := t.checkInProgress(); err != nil {
+		return false, err
+	}
+	player, err := t.getActivePlayer(playerIndex)
+	if err != nil {
+		return false, err
+	}
+
+	frame := player.frameInProgress()
+	if err = frame.RollPinMask(knocked); err != nil {
+		return false, err
+	}
+	return frame.IsComplete(), nil
+}
+
+func (g *baseGame) RollBy(playerIndex int, bowler string, pins int) (bool, error) {
+	return g.rollBy(playerIndex, bowler, func(frame Frame) error {
+		return frame.Roll(pins)
+	})
+}
+
+func (g *baseGame) RollPinsBy(playerIndex int, bowler string, knocked PinMask) (bool, error) {
+	return g.rollBy(playerIndex, bowler, func(frame Frame) error {
+		return frame.RollPinMask(knocked)
+	})
+}
+
+// rollBy appends a roll to the current frame of a player if the bowler is the bowler of the frame
+func (g *baseGame) rollBy(playerIndex int, bowler string, roll func(frame Frame) error) (bool, error) {
+	if err := g.checkInProgress(); err != nil {
+		return false, err
+	}
+	player, err := g.getActivePlayer(playerIndex)
+	if err != nil {
+		return false, err
+	}
+	if next := player.bowlers[player.currentFrame]; bowler != next {
+		return false, fmt.Errorf("it is the turn of %s", next)
+	}
+
+	frame := player.frameInProgress()
+	if err = roll(frame); err != nil {
+		return false, err
+	}
+	return frame.IsComplete(), nil
+}
+
+func (g *baseGame) CorrectFrame(playerIndex int, frameIndex int, pins ...int) error {
+	frame, err := g.getReachedFrame(playerIndex, frameIndex)
+	if err != nil {
+		return err
+	}
+
+	return frame.KnockPins(pins...)
+}
+
+func (g *baseGame) CorrectFramePins(playerIndex int, frameIndex int, knocked ...PinMask) error {
+	frame, err := g.getReachedFrame(playerIndex, frameIndex)
+	if err != nil {
+		return err
+	}
+
+	return frame.KnockPinMasks(knocked...)
+}
+
+func (g *baseGame) CorrectFrameLeaves(playerIndex int, frameIndex int, leaves ...PinMask) error {
+	rules := g.GetRules()
+	knocked, err := rules.masksFromLeaves(leaves)
+	if err != nil {
+		return err
+	}
+
+	return g.CorrectFramePins(playerIndex, frameIndex, knocked...)
+}
+
+// getReachedFrame returns a frame which a player has already reached, which can be corrected.
+// The frames nobody bowled can't be corrected, eg the frames a late player missed,
+// and neither can the frames of a blind player, who is scored with a blind score instead.
+func (g *baseGame) getReachedFrame(playerIndex int, frameIndex int) (Frame, error) {
+	player, err := g.getPlayer(playerIndex)
+	if err != nil {
+		return nil, err
+	}
+	if player.IsBlind() {
+		return nil, errors.New("player is blind")
+	}
+	if frameIndex < 0 || frameIndex > player.currentFrame {
+		return nil, errors.New("invalid frame index")
+	}
+	if player.isMissedFrame(frameIndex) || player.bowlers[frameIndex] == "" {
+		return nil, errors.New("frame was not bowled")
+	}
+	return player.frames[frameIndex], nil
 }
 
 // Player contains the name and roll results by frame of a player in a game
 type Player struct {
+	// name is the name of the current bowler of the player
 	name   string
-	frames [10]Frame
+	frames []Frame
+	// currentFrame is the index of the frame in progress of the player
+	currentFrame int
+	// bowlers contains the name of the bowler of each frame, which is empty when nobody bowled the frame,
+	// eg before a late player joined the game
+	bowlers []string
+	// substitutes contains the substitutes who replace the bowler of the player from a later frame, by frame
+	substitutes map[int]string
+	// withdrawn is whether the player stopped before the end of the game
+	withdrawn bool
+	// blindScore is the score of an absent player, who is scored without rolls
+	blindScore *int
+	// joinedFrame is the frame a late player joined the game at, which is 0 for the other players.
+	// Nobody bowls the frames before it.
+	joinedFrame int
+	// missedScores contains the scores of the frames a late player missed before joining the game,
+	// which are filled in with the blind rule of the game instead of rolls
+	missedScores []int
+	rules        *Rules
 }
 
+// NewPlayer creates a player of a 10-pin bowling game
 func NewPlayer(name string) *Player {
-	var frames [10]Frame
-	for i := 0; i < 9; i++ {
-		frames[i] = &normalFrame{}
+	return newPlayer(name, &tenPinRules)
+}
+
+func newPlayer(name string, rules *Rules) *Player {
+	frames := make([]Frame, rules.NumFrames)
+	for i := 0; i < rules.NumFrames-1; i++ {
+		frames[i] = newNormalFrame(rules)
+	}
+	// there is no fill ball with the current frame scoring system, so the last frame is played as other frames
+	if rules.ScoringSystem == configs.CurrentFrame {
+		frames[rules.NumFrames-1] = newNormalFrame(rules)
+	} else {
+		frames[rules.NumFrames-1] = newLastFrame(rules)
 	}
-	frames[9] = &lastFrame{}
 
 	return &Player{
-		name:   name,
-		frames: frames,
+		name:    name,
+		frames:  frames,
+		bowlers: slices.Repeat([]string{name}, rules.NumFrames),
+		rules:   rules,
+	}
+}
+
+// GetCurrentFrame returns the index of the frame in progress of the player
+func (p *Player) GetCurrentFrame() int {
+	return p.currentFrame
+}
+
+func (p *Player) frameInProgress() Frame {
+	return p.frames[p.currentFrame]
+}
+
+// firstUnbowledFrame returns the index of the first frame the player hasn't rolled in yet.
+// The frames before it are either bowled, or were left empty for a late player.
+func (p *Player) firstUnbowledFrame() int {
+	if len(p.frameInProgress().GetPins()) > 0 {
+		return p.currentFrame + 1
 	}
+	return p.currentFrame
+}
+
+// nextFrame moves the player to the next frame, and returns the current frame of the player
+func (p *Player) nextFrame() int {
+	if p.currentFrame < len(p.frames)-1 {
+		p.currentFrame++
+	}
+	if name, ok := p.substitutes[p.currentFrame]; ok {
+		p.name = name
+	}
+	return p.currentFrame
+}
+
+// IsDone returns whether the player has completed the last frame, withdrawn or is blind
+func (p *Player) IsDone() bool {
+	return !p.isActive() || p.hasCompletedLastFrame()
+}
+
+func (p *Player) hasCompletedLastFrame() bool {
+	return p.currentFrame == len(p.frames)-1 && p.frames[p.currentFrame].IsComplete()
 }
 
 func (p *Player) GetFrameResults() [][]int {
@@ -97,144 +565,548 @@ func (p *Player) GetFrameResults() [][]int {
 	return res
 }
 
-// GetScores calculates the scores of all frames
+// GetFrameFouls returns the indexes of the foul rolls of all frames, or nil if there is no foul
+func (p *Player) GetFrameFouls() [][]int {
+	var res [][]int
+	found := false
+	for _, frame := range p.frames {
+		fouls := frame.GetFouls()
+		found = found || len(fouls) > 0
+		res = append(res, fouls)
+	}
+	if !found {
+		return nil
+	}
+	return res
+}
+
+// GetFramePinMasks returns the pins knocked by each roll of all frames,
+// or nil if all frames are set with the numbers of pins
+func (p *Player) GetFramePinMasks() [][]PinMask {
+	return p.collectMasks(Frame.GetPinMasks)
+}
+
+// GetFrameLeaves returns the pins left standing after each roll of all frames,
+// or nil if all frames are set with the numbers of pins
+func (p *Player) GetFrameLeaves() [][]PinMask {
+	return p.collectMasks(Frame.GetLeaves)
+}
+
+func (p *Player) collectMasks(get func(Frame) []PinMask) [][]PinMask {
+	var res [][]PinMask
+	found := false
+	for _, frame := range p.frames {
+		masks := get(frame)
+		found = found || masks != nil
+		res = append(res, masks)
+	}
+	if !found {
+		return nil
+	}
+	return res
+}
+
+// GetFrameSplits returns the splits and washouts of all frames,
+// or nil if all frames are set with the numbers of pins or the rack is not a 10-pin rack
+func (p *Player) GetFrameSplits() [][]Split {
+	if p.rules.NumPins != len(triangleLayout) || p.GetFramePinMasks() == nil {
+		return nil
+	}
+
+	var res [][]Split
+	for _, frame := range p.frames {
+		res = append(res, frame.GetSplits())
+	}
+	return res
+}
+
+// GetScores calculates the scores of all frames.
+// Each frame looks ahead at the rolls of the following frames for its bonus.
 func (p *Player) GetScores() []int {
 	var res []int
-	for i, f := range p.frames {
-		switch frame := f.(type) {
+	for i, frame := range p.frames {
+		if i < len(p.missedScores) {
+			res = append(res, p.missedScores[i])
+			continue
+		}
+		res = append(res, frame.GetScore(p.nextRolls(i)))
+	}
+	return res
+}
+
+// GetMaxScore returns the max possible total score, which is when all remaining balls are strikes
+func (p *Player) GetMaxScore() int {
+	if p.blindScore != nil {
+		return *p.blindScore
+	}
+	return lo.Sum(p.simulate(true).GetScores())
+}
+
+// GetMinScore returns the total score guaranteed, which is when all remaining balls miss
+func (p *Player) GetMinScore() int {
+	if p.blindScore != nil {
+		return *p.blindScore
+	}
+	return lo.Sum(p.simulate(false).GetScores())
+}
+
+// GetProjectedScore returns the total score at the average score of the resolved frames,
+// or nil if no frame is resolved yet. The frames a late player missed are not in the average:
+// their blind scores are added as they are, and the frames without blind score are projected at the average.
+func (p *Player) GetProjectedScore() *int {
+	if p.blindScore != nil {
+		return lo.ToPtr(*p.blindScore)
+	}
+	total, count := 0, 0
+	scores := p.GetScores()
+	for i, resolved := range p.GetResolvedFrames() {
+		if resolved && !p.isMissedFrame(i) {
+			total += scores[i]
+			count++
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+
+	projected := lo.Sum(p.missedScores) + total*(len(p.frames)-len(p.missedScores))/count
+	return lo.ToPtr(lo.Clamp(projected, p.GetMinScore(), p.GetMaxScore()))
+}
+
+// isMissedFrame returns whether a late player missed a frame, which nobody bowled
+func (p *Player) isMissedFrame(frameIndex int) bool {
+	return frameIndex < p.joinedFrame
+}
+
+// simulate returns a copy of the player where the frames in progress and the following frames are completed.
+// Each remaining roll knocks all standing pins if best, or no pin otherwise.
+func (p *Player) simulate(best bool) *Player {
+	res := &Player{
+		name:         p.name,
+		currentFrame: p.currentFrame,
+		bowlers:      p.bowlers,
+		withdrawn:    p.withdrawn,
+		joinedFrame:  p.joinedFrame,
+		missedScores: p.missedScores,
+		rules:        p.rules,
+	}
+	for i, frame := range p.frames {
+		if i < p.currentFrame || frame.IsComplete() || p.withdrawn {
+			res.frames = append(res.frames, frame)
+			continue
+		}
+
+		switch f := frame.(type) {
 		case *normalFrame:
-			var nextRolls []int
-			j := i + 1
-			for j < len(p.frames) {
-				nextRolls = append(nextRolls, p.frames[j].GetPins()...)
-				j++
-			}
-			res = append(res, frame.GetScore(nextRolls))
+			frame = &normalFrame{f.completed(best)}
 		case *lastFrame:
-			res = append(res, frame.GetScore())
+			frame = &lastFrame{f.completed(best)}
+		}
+		res.frames = append(res.frames, frame)
+	}
+	return res
+}
+
+// nextRolls returns the scored rolls of the frames after a frame
+func (p *Player) nextRolls(frameIndex int) []int {
+	var res []int
+	for j := frameIndex + 1; j < len(p.frames); j++ {
+		res = append(res, p.frames[j].GetScoredPins()...)
+	}
+	return res
+}
+
+// GetResolvedFrames returns whether the score of each frame is final,
+// which is when the frame is complete or skipped, and its bonus balls are rolled.
+// All frames of a withdrawn or blind player are resolved, except the frames a late player missed
+// without blind score, which have no score.
+func (p *Player) GetResolvedFrames() []bool {
+	var res []bool
+	for i, frame := range p.frames {
+		if p.isMissedFrame(i) {
+			res = append(res, i < len(p.missedScores))
+			continue
 		}
+		played := frame.IsComplete() || i < p.currentFrame
+		res = append(res, !p.isActive() || (played && frame.GetMissingBonusBalls(p.nextRolls(i)) == 0))
+	}
+	return res
+}
+
+// GetCumulativeScores returns the running total of each frame as shown on scoresheets,
+// or nil for the frames after the first one which is not resolved.
+// The frames a late player missed without blind score have no running total.
+func (p *Player) GetCumulativeScores() []*int {
+	res := make([]*int, len(p.frames))
+	// a blind has no frame scores, only a total score
+	if p.blindScore != nil {
+		return res
+	}
+	total := 0
+	scores := p.GetScores()
+	for i, resolved := range p.GetResolvedFrames() {
+		if p.isMissedFrame(i) && !resolved {
+			continue
+		}
+		if !resolved {
+			break
+		}
+		total += scores[i]
+		res[i] = lo.ToPtr(total)
 	}
 	return res
 }
 
 // Frame represents the frame result of a player
 type Frame interface {
-	// KnockPins set the number of pins knocked in the frame
+	// KnockPins set the number of pins knocked in the frame. A foul roll is set with Foul,
+	// and a strike or spare can be set with Strike or Spare.
 	KnockPins(pins ...int) error
+	// KnockPinMasks set the pins knocked by each roll in the frame. A foul roll is set with FoulMask.
+	KnockPinMasks(masks ...PinMask) error
+	// Roll appends a roll to the frame in progress. pins can be Foul.
+	Roll(pins int) error
+	// RollPinMask appends a roll knocking pins to the frame in progress,
+	// whose previous rolls must also be set with the knocked pins. mask can be FoulMask.
+	RollPinMask(mask PinMask) error
+	// IsComplete returns whether all balls of the frame are rolled
+	IsComplete() bool
 	GetPins() []int
+	// GetFouls returns the indexes of the rolls which are fouls. A foul knocks no pin but counts as a roll.
+	GetFouls() []int
+	// GetPinMasks returns the pins knocked by each roll, or nil if only the numbers of pins are known
+	GetPinMasks() []PinMask
+	// GetLeaves returns the pins left standing after each roll, or nil if only the numbers of pins are known
+	GetLeaves() []PinMask
+	// GetSplits returns the splits and washouts left in the frame, which are only known with the pins knocked
+	GetSplits() []Split
+	// GetScoredPins returns the values of the rolls used for scoring, eg a strike in no-tap bowling counts as all pins
+	GetScoredPins() []int
+	// GetScore calculates the score of the frame, including the bonus from nextRolls,
+	// which contains the scored rolls of the following frames in order
+	GetScore(nextRolls []int) int
+	// GetMissingBonusBalls returns the number of bonus balls which are not in nextRolls yet
+	GetMissingBonusBalls(nextRolls []int) int
 }
 
-// normalFrame represents frame 1-9 in 10-pin bowling game
-type normalFrame struct {
-	pins []int
+// Foul is the roll of a foul in the input of frame results.
+// A foul knocks no pin, so a foul on the first ball leaves all pins standing for the second ball, which can only make a spare.
+const Foul = -1
+
+// splitFouls replaces the fouls in the rolls of a frame with 0 pins, and returns the indexes of the fouls
+func splitFouls(pins []int) ([]int, []int) {
+	var fouls []int
+	res := slices.Clone(pins)
+	for i, e := range res {
+		if e == Foul {
+			res[i] = 0
+			fouls = append(fouls, i)
+		}
+	}
+	return res, fouls
 }
 
-func (n *normalFrame) KnockPins(pins ...int) error {
-	// strike
-	if pins[0] == numPin {
-		if len(pins) > 1 {
-			return errors.New("invalid input: len must be 1 for strike")
+// Strike and Spare are the rolls of X and / in the input of frame results, whose numbers of pins depend on the rack:
+// a strike knocks all pins, and a spare knocks the pins left standing by the first roll of the frame.
+const Strike = -2
+const Spare = -3
+
+// resolveMarks replaces the strikes and spares in the rolls of a frame with the numbers of pins they knock.
+// Fouls must already be replaced with 0 pins.
+func (r *Rules) resolveMarks(pins []int) ([]int, error) {
+	res := slices.Clone(pins)
+	for i, e := range res {
+		switch e {
+		case Strike:
+			res[i] = r.rackValue()
+		case Spare:
+			if i != 1 {
+				return nil, errors.New("invalid input: spare must be the second roll")
+			}
+			res[i] = max(r.rackValue()-res[0], 0)
 		}
-		n.pins = []int{numPin}
-		return nil
 	}
+	return res, nil
+}
 
-	if len(pins) != 2 {
-		return errors.New("invalid input: len must be 2 for non-strike")
+// FoulMask is the roll of a foul in the input of pin masks, which knocks no pin like Foul
+const FoulMask PinMask = 1 << 31
+
+// splitMaskFouls replaces the fouls in the pin masks of a frame with no pin, and returns the indexes of the fouls
+func splitMaskFouls(masks []PinMask) ([]PinMask, []int) {
+	var fouls []int
+	res := slices.Clone(masks)
+	for i, e := range res {
+		if e == FoulMask {
+			res[i] = 0
+			fouls = append(fouls, i)
+		}
 	}
+	return res, fouls
+}
+
+// PinMask is a set of pins, where bit i represents pin number i+1 in the rack
+type PinMask uint32
 
-	if !validPins(pins[0], pins[1]) {
-		return errors.New("invalid input. sum must <= 2.")
+// frameRolls contains the rolls of a frame, which are entered the same way in all frames.
+// The frames only differ by their fill balls and their scores.
+type frameRolls struct {
+	*Rules
+	pins  []int
+	masks []PinMask
+	// fouls contains the indexes of the rolls which are fouls
+	fouls []int
+	// strikeFill and spareFill are the numbers of extra balls earned by a strike or a spare,
+	// which are only rolled in the last frame. Otherwise a strike or spare ends the frame.
+	strikeFill int
+	spareFill  int
+}
+
+func (f *frameRolls) KnockPins(pins ...int) error {
+	if len(f.PinValues) > 0 {
+		return errors.New("invalid input: knocked pins must be specified for this game")
+	}
+	pins, fouls := splitFouls(pins)
+	pins, err := f.resolveMarks(pins)
+	if err != nil {
+		return err
+	}
+	if err = f.validate(pins); err != nil {
+		return err
 	}
 
-	n.pins = pins
+	f.pins = pins
+	f.masks = nil
+	f.fouls = fouls
 	return nil
 }
 
-func (n *normalFrame) GetPins() []int {
-	return n.pins
+func (f *frameRolls) KnockPinMasks(masks ...PinMask) error {
+	masks, fouls := splitMaskFouls(masks)
+	pins, err := f.pinsFromMasks(masks)
+	if err != nil {
+		return err
+	}
+	if err = f.validate(pins); err != nil {
+		return err
+	}
+
+	f.pins = pins
+	f.masks = masks
+	f.fouls = fouls
+	return nil
+}
+
+func (f *frameRolls) Roll(pins int) error {
+	// a foul knocks no pin, so it can also be added to a frame set with the knocked pins.
+	// A foul on the first ball is also stored as a pin mask, so the next ball can be rolled either way.
+	if pins == Foul && (len(f.PinValues) > 0 || f.masks != nil || len(f.pins) == 0) {
+		return f.RollPinMask(FoulMask)
+	}
+	if len(f.PinValues) > 0 {
+		return errors.New("invalid input: knocked pins must be specified for this game")
+	}
+	if f.IsComplete() {
+		return errors.New("invalid input: frame is complete")
+	}
+
+	fouls := slices.Clone(f.fouls)
+	if pins == Foul {
+		fouls = append(fouls, len(f.pins))
+		pins = 0
+	}
+	rolls := append(slices.Clone(f.pins), pins)
+	if _, err := f.checkRolls(rolls, f.strikeFill, f.spareFill); err != nil {
+		return err
+	}
+
+	f.pins = rolls
+	f.masks = nil
+	f.fouls = fouls
+	return nil
+}
+
+func (f *frameRolls) RollPinMask(mask PinMask) error {
+	if f.IsComplete() {
+		return errors.New("invalid input: frame is complete")
+	}
+	if len(f.pins) > 0 && f.masks == nil {
+		return errors.New("invalid input: previous rolls of the frame are set without the knocked pins")
+	}
+
+	fouls := slices.Clone(f.fouls)
+	if mask == FoulMask {
+		fouls = append(fouls, len(f.pins))
+		mask = 0
+	}
+	masks := append(slices.Clone(f.masks), mask)
+	pins, err := f.pinsFromMasks(masks)
+	if err != nil {
+		return err
+	}
+	if _, err = f.checkRolls(pins, f.strikeFill, f.spareFill); err != nil {
+		return err
+	}
+
+	f.pins = pins
+	f.masks = masks
+	f.fouls = fouls
+	return nil
+}
+
+func (f *frameRolls) IsComplete() bool {
+	numBall, _ := f.checkRolls(f.pins, f.strikeFill, f.spareFill)
+	return len(f.pins) >= numBall
+}
+
+func (f *frameRolls) validate(pins []int) error {
+	if len(pins) == 0 {
+		return errors.New("invalid input: pins is empty")
+	}
+
+	numBall, err := f.checkRolls(pins, f.strikeFill, f.spareFill)
+	if err != nil {
+		return err
+	}
+	if len(pins) != numBall {
+		return fmt.Errorf("invalid input: len must be %d", numBall)
+	}
+	return nil
+}
+
+// completed returns a copy of the rolls where the frame is completed.
+// Each remaining roll knocks all standing pins if best, or no pin otherwise.
+func (f *frameRolls) completed(best bool) frameRolls {
+	return frameRolls{
+		Rules:      f.Rules,
+		pins:       f.completeRolls(f.pins, f.strikeFill, f.spareFill, best),
+		strikeFill: f.strikeFill,
+		spareFill:  f.spareFill,
+	}
+}
+
+func (f *frameRolls) GetPins() []int {
+	return f.pins
+}
+
+func (f *frameRolls) GetFouls() []int {
+	return f.fouls
+}
+
+func (f *frameRolls) GetPinMasks() []PinMask {
+	return f.masks
+}
+
+func (f *frameRolls) GetLeaves() []PinMask {
+	return f.leaves(f.masks)
+}
+
+func (f *frameRolls) GetSplits() []Split {
+	return f.splits(f.masks)
+}
+
+func (f *frameRolls) GetScoredPins() []int {
+	return f.scoredPins(f.pins)
+}
+
+// normalFrame represents a frame other than the last one, where a strike or spare ends the frame
+type normalFrame struct {
+	frameRolls
+}
+
+func newNormalFrame(rules *Rules) *normalFrame {
+	return &normalFrame{frameRolls{Rules: rules}}
 }
 
 func (n *normalFrame) GetScore(nextRolls []int) int {
+	if n.ScoringSystem == configs.CurrentFrame {
+		return n.getCurrentFrameScore()
+	}
+
 	res := 0
-	for _, e := range n.pins {
+	for _, e := range n.GetScoredPins() {
 		res += e
 	}
 
-	bonusRolls := 0
+	for i := 0; i < len(nextRolls) && i < n.bonusBalls(); i++ {
+		res += nextRolls[i]
+	}
+
+	if n.isSpare() {
+		res *= n.spareMultiplier()
+	}
+	return res
+}
+
+// bonusBalls returns the number of next balls counted as the bonus of the frame
+func (n *normalFrame) bonusBalls() int {
 	if n.isStrike() {
-		bonusRolls = 2
-	} else if n.isSpare() {
-		bonusRolls = 1
+		return n.StrikeBonusBalls
 	}
-	for i := 0; i < len(nextRolls) && i < bonusRolls; i++ {
-		res += nextRolls[i]
+	if n.isSpare() {
+		return n.SpareBonusBalls
+	}
+	return 0
+}
+
+func (n *normalFrame) GetMissingBonusBalls(nextRolls []int) int {
+	// there is no bonus from the next frames with the current frame scoring system
+	if n.ScoringSystem == configs.CurrentFrame {
+		return 0
+	}
+	return max(n.bonusBalls()-len(nextRolls), 0)
+}
+
+// getCurrentFrameScore calculates the score of the frame with the current frame scoring system,
+// where a strike scores a rack for itself and each bonus ball (30 in 10-pin),
+// a spare scores a rack plus the first ball for each bonus ball, and there is no bonus from the next frames.
+func (n *normalFrame) getCurrentFrameScore() int {
+	if n.isStrike() {
+		return (1 + n.StrikeBonusBalls) * n.rackValue()
+	}
+	if n.isSpare() {
+		return (n.rackValue() + n.SpareBonusBalls*n.GetScoredPins()[0]) * n.spareMultiplier()
 	}
 
+	res := 0
+	for _, e := range n.GetScoredPins() {
+		res += e
+	}
 	return res
 }
 
 func (n *normalFrame) isStrike() bool {
-	return len(n.pins) >= 1 && n.pins[0] == numPin
+	return len(n.pins) >= 1 && n.pins[0] >= n.strikePins()
 }
 
 func (n *normalFrame) isSpare() bool {
-	return len(n.pins) >= 2 && n.pins[0]+n.pins[1] == numPin
+	return len(n.pins) >= 2 && !n.isStrike() && n.pins[0]+n.pins[1] == n.rackValue()
 }
 
-// lastFrame represents the last frame (10) in 10-pin bowling game
+// lastFrame represents the last frame of a game, where a strike or spare earns fill balls
 type lastFrame struct {
-	pins []int
+	frameRolls
 }
 
-func (l *lastFrame) KnockPins(pins ...int) error {
-	if len(pins) < 2 {
-		return errors.New("invalid input: len must be at least 2 for last frame")
-	}
-	if pins[0] > numPin {
-		return errors.New("invalid input for first roll")
-	}
-	// strike
-	if pins[0] == numPin {
-		if len(pins) < 3 {
-			return errors.New("invalid input: len must be 3 for last frame strike")
-		}
-		if pins[1] == numPin {
-			if pins[2] > numPin {
-				return errors.New("invalid input for last roll")
-			}
-		} else if !validPins(pins[1], pins[2]) {
-			return errors.New("invalid input for 2 last roll")
-		}
-	} else if pins[0]+pins[1] == numPin { // spare
-		if len(pins) < 3 {
-			return errors.New("invalid input: len must be 3 for last frame spare")
-		}
-		if pins[2] > numPin {
-			return errors.New("invalid input for last roll")
-		}
-	} else {
-		if len(pins) != 2 {
-			return errors.New("invalid input: len must be 2 for last frame open")
-		}
-	}
-
-	l.pins = pins
-	return nil
+func newLastFrame(rules *Rules) *lastFrame {
+	return &lastFrame{frameRolls{Rules: rules, strikeFill: rules.StrikeFillBalls, spareFill: rules.SpareFillBalls}}
 }
 
-func (l *lastFrame) GetPins() []int {
-	return l.pins
+func (l *lastFrame) GetMissingBonusBalls(nextRolls []int) int {
+	return 0
 }
 
-func (l *lastFrame) GetScore() int {
+// GetScore calculates the score of the last frame, which doesn't take bonus from nextRolls.
+// The fill balls of a spare are its bonus, so they are multiplied with the spare.
+func (l *lastFrame) GetScore(nextRolls []int) int {
 	res := 0
-	for _, e := range l.pins {
+	for _, e := range l.GetScoredPins() {
 		res += e
 	}
-	return res
-}
 
-func validPins(firstRoll, secondRoll int) bool {
-	return firstRoll >= 0 && secondRoll >= 0 && firstRoll+secondRoll <= numPin
+	scored := l.GetScoredPins()
+	if len(scored) >= 2 && scored[0] < l.strikePins() && scored[0]+scored[1] == l.rackValue() {
+		res *= l.spareMultiplier()
+	}
+	return res
 }
@@ -3,8 +3,11 @@ package core
 import (
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"bowling-score-tracker/configs"
 )
 
 func TestTenPinGame(t *testing.T) {
@@ -47,6 +50,20 @@ func TestTenPinGame(t *testing.T) {
 			}
 			assert.Error(t, game.StartGame(names))
 		})
+
+		t.Run("should_reject_unsupported_scoring_system", func(t *testing.T) {
+			game := &TenPinGame{baseGame: baseGame{scoring: "abc"}}
+			assert.Error(t, game.StartGame([]string{"hung"}))
+		})
+
+		t.Run("should_play_last_frame_as_normal_frame_with_current_frame_scoring", func(t *testing.T) {
+			game := &TenPinGame{baseGame: baseGame{scoring: configs.CurrentFrame}}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			game.players[0].currentFrame = 9
+
+			assert.Error(t, game.SetFrameResult(0, 10, 10, 10), "no fill ball with current frame scoring")
+			assert.NoError(t, game.SetFrameResult(0, 10))
+		})
 	})
 
 	t.Run("GetPlayers", func(t *testing.T) {
@@ -69,7 +86,9 @@ func TestTenPinGame(t *testing.T) {
 
 	t.Run("NextFrame", func(t *testing.T) {
 		t.Run("when_increase_frame_below_9", func(t *testing.T) {
-			game := &TenPinGame{currentFrame: 4}
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			game.players[0].currentFrame = 4
 
 			game.NextFrame()
 
@@ -77,12 +96,367 @@ func TestTenPinGame(t *testing.T) {
 		})
 
 		t.Run("when_frame_is_last", func(t *testing.T) {
-			game := &TenPinGame{currentFrame: 9}
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			game.players[0].currentFrame = 9
 
 			game.NextFrame()
 
 			assert.Equal(t, 9, game.GetCurrentFrame(), "should_not_increment_frame")
 		})
+
+		t.Run("should_only_move_players_at_lowest_frame", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung", "thuy"}))
+			game.players[1].currentFrame = 2
+
+			res := game.NextFrame()
+
+			assert.Equal(t, 1, res)
+			assert.Equal(t, 1, game.players[0].currentFrame)
+			assert.Equal(t, 2, game.players[1].currentFrame)
+		})
+	})
+
+	t.Run("NextPlayerFrame", func(t *testing.T) {
+		t.Run("should_reject_invalid_player_index", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			_, err := game.NextPlayerFrame(1)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("should_let_player_enter_frame_ahead_of_others", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung", "thuy"}))
+
+			res, err := game.NextPlayerFrame(1)
+			require.NoError(t, err)
+			require.NoError(t, game.SetFrameResult(1, 3, 4))
+
+			assert.Equal(t, 1, res)
+			assert.Equal(t, 0, game.GetCurrentFrame(), "current frame of game should be the lowest frame in progress")
+			assert.Equal(t, []int{3, 4}, game.players[1].frames[1].GetPins())
+			assert.Nil(t, game.players[0].frames[1].GetPins())
+		})
+
+		t.Run("should_not_move_after_last_frame", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			game.players[0].currentFrame = 9
+
+			res, err := game.NextPlayerFrame(0)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 9, res)
+		})
+	})
+
+	t.Run("Fouls", func(t *testing.T) {
+		t.Run("foul_on_first_ball_leaves_spare_for_second_ball", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			err := game.SetFrameResult(0, Foul, 10)
+
+			assert.NoError(t, err)
+			frame := game.GetPlayers()[0].frames[0]
+			assert.Equal(t, []int{0, 10}, frame.GetPins())
+			assert.Equal(t, []int{0}, frame.GetFouls())
+			assert.True(t, frame.(*normalFrame).isSpare(), "10 pins with the second ball after a foul is a spare")
+		})
+
+		t.Run("foul_counts_as_roll", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			assert.Error(t, game.SetFrameResult(0, 7, Foul, 3), "foul is the second roll of the frame")
+			assert.NoError(t, game.SetFrameResult(0, 7, Foul))
+			assert.Equal(t, []int{7}, game.GetPlayers()[0].GetScores()[:1])
+		})
+
+		t.Run("foul_with_roll_by_roll", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			complete, err := game.Roll(0, Foul)
+			require.NoError(t, err)
+			assert.False(t, complete)
+			complete, err = game.Roll(0, Foul)
+			require.NoError(t, err)
+			assert.True(t, complete)
+
+			assert.Equal(t, []int{0, 0}, game.GetPlayers()[0].frames[0].GetPins())
+			assert.Equal(t, []int{0, 1}, game.GetPlayers()[0].frames[0].GetFouls())
+		})
+
+		t.Run("foul_on_first_ball_accepts_next_ball_with_knocked_pins_or_count", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung", "thuy"}))
+
+			_, err := game.Roll(0, Foul)
+			require.NoError(t, err)
+			complete, err := game.RollPins(0, pins(1, 2, 3))
+			require.NoError(t, err)
+			assert.True(t, complete)
+			assert.Equal(t, []PinMask{0, pins(1, 2, 3)}, game.GetPlayers()[0].frames[0].GetPinMasks())
+
+			_, err = game.Roll(1, Foul)
+			require.NoError(t, err)
+			_, err = game.Roll(1, 3)
+			require.NoError(t, err)
+			assert.Equal(t, []int{0, 3}, game.GetPlayers()[1].frames[0].GetPins())
+			assert.Equal(t, []int{0}, game.GetPlayers()[1].frames[0].GetFouls())
+		})
+
+		t.Run("foul_on_fill_ball", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			game.players[0].currentFrame = 9
+
+			err := game.SetFrameResult(0, 10, 10, Foul)
+
+			assert.NoError(t, err)
+			assert.Equal(t, [][]int{nil, nil, nil, nil, nil, nil, nil, nil, nil, {2}}, game.GetPlayers()[0].GetFrameFouls())
+			assert.Equal(t, 20, game.GetPlayers()[0].GetScores()[9])
+		})
+
+		t.Run("should_clear_fouls_when_frame_is_replaced", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			require.NoError(t, game.SetFrameResult(0, Foul, 4))
+
+			require.NoError(t, game.CorrectFrame(0, 0, 3, 4))
+
+			assert.Nil(t, game.GetPlayers()[0].GetFrameFouls())
+		})
+	})
+
+	t.Run("GetStatus", func(t *testing.T) {
+		t.Run("should_complete_when_all_players_complete_last_frame", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung", "thuy"}))
+			for _, e := range game.players {
+				e.currentFrame = 9
+			}
+			require.NoError(t, game.SetFrameResult(0, 3, 4))
+			assert.Equal(t, configs.InProgress, game.GetStatus())
+
+			require.NoError(t, game.SetFrameResult(1, 10, 10, 10))
+
+			assert.Equal(t, configs.Completed, game.GetStatus())
+			assert.Error(t, game.SetFrameResult(0, 3, 5), "frame results can't be set once the game is completed")
+			assert.NoError(t, game.CorrectFrame(0, 9, 3, 5), "frames can still be corrected")
+		})
+
+		t.Run("should_abandon_game_when_every_player_withdraws", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung", "thuy"}))
+			require.NoError(t, game.SetFrameResult(0, 3, 4))
+
+			require.NoError(t, game.WithdrawPlayer(0))
+			assert.Equal(t, configs.InProgress, game.GetStatus())
+			require.NoError(t, game.WithdrawPlayer(1))
+
+			assert.False(t, game.IsFinished(), "nobody has bowled the last frame")
+			assert.Equal(t, configs.Abandoned, game.GetStatus())
+			assert.Nil(t, game.GetStandings())
+		})
+
+		t.Run("should_abandon_game_in_progress", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			require.NoError(t, game.Abandon())
+
+			assert.Equal(t, configs.Abandoned, game.GetStatus())
+			assert.Error(t, game.Abandon())
+			assert.Error(t, game.SetFrameResult(0, 3, 5))
+			_, err := game.Roll(0, 3)
+			assert.Error(t, err)
+			assert.Equal(t, 0, game.NextFrame())
+			assert.Nil(t, game.GetStandings())
+		})
+	})
+
+	t.Run("GetStandings", func(t *testing.T) {
+		t.Run("should_be_empty_while_game_is_in_progress", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			assert.Nil(t, game.GetStandings())
+		})
+
+		t.Run("should_rank_players_with_ties", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung", "thuy", "max", "min"}))
+			for _, e := range game.players {
+				e.currentFrame = 9
+			}
+			require.NoError(t, game.SetFrameResult(0, 3, 4))
+			require.NoError(t, game.SetFrameResult(1, 10, 10, 10))
+			require.NoError(t, game.SetFrameResult(2, 3, 4))
+			require.NoError(t, game.SetFrameResult(3, 1, 1))
+
+			assert.Equal(t, []Standing{
+				{Place: 1, PlayerIndex: 1, Name: "thuy", TotalScore: 30, Winner: true},
+				{Place: 2, PlayerIndex: 0, Name: "hung", TotalScore: 7},
+				{Place: 2, PlayerIndex: 2, Name: "max", TotalScore: 7},
+				{Place: 4, PlayerIndex: 3, Name: "min", TotalScore: 2},
+			}, game.GetStandings())
+		})
+
+		t.Run("should_rank_withdrawn_players_below_players_who_finished", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung", "thuy", "max"}))
+			require.NoError(t, game.SetFrameResult(1, 10))
+			require.NoError(t, game.SetFrameResult(2, 10))
+			game.NextFrame()
+			require.NoError(t, game.SetFrameResult(1, 10))
+			require.NoError(t, game.WithdrawPlayer(1))
+			require.NoError(t, game.WithdrawPlayer(2))
+			game.players[0].currentFrame = 9
+			require.NoError(t, game.SetFrameResult(0, 3, 4))
+
+			assert.Equal(t, []Standing{
+				{Place: 1, PlayerIndex: 0, Name: "hung", TotalScore: 7, Winner: true},
+				{Place: 2, PlayerIndex: 1, Name: "thuy", TotalScore: 30, Withdrawn: true},
+				{Place: 3, PlayerIndex: 2, Name: "max", TotalScore: 10, Withdrawn: true},
+			}, game.GetStandings())
+		})
+
+		t.Run("should_rank_late_player_by_pins_bowled", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			for i := 0; i < 10; i++ {
+				if i == 3 {
+					require.NoError(t, game.AddPlayer("lan", lo.ToPtr(140)))
+				}
+				require.NoError(t, game.SetFrameResult(0, 7, 0))
+				if i >= 3 {
+					require.NoError(t, game.SetFrameResult(1, 7, 0))
+				}
+				game.NextFrame()
+			}
+
+			require.Equal(t, configs.Completed, game.GetStatus())
+			assert.Equal(t, 3*14+7*7, game.GetPlayers()[1].GetTotalScore())
+			assert.Equal(t, []Standing{
+				{Place: 1, PlayerIndex: 0, Name: "hung", TotalScore: 70, Winner: true},
+				{Place: 2, PlayerIndex: 1, Name: "lan", TotalScore: 49},
+			}, game.GetStandings())
+		})
+
+		t.Run("should_have_several_winners_in_case_of_tie", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung", "thuy"}))
+			for _, e := range game.players {
+				e.currentFrame = 9
+				require.NoError(t, e.frames[9].KnockPins(3, 4))
+			}
+
+			standings := game.GetStandings()
+
+			assert.True(t, standings[0].Winner)
+			assert.True(t, standings[1].Winner)
+		})
+	})
+
+	t.Run("CorrectFrame", func(t *testing.T) {
+		t.Run("should_reject_frame_not_reached", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			assert.Error(t, game.CorrectFrame(0, 1, 3, 4))
+			assert.Error(t, game.CorrectFrame(0, -1, 3, 4))
+			assert.Error(t, game.CorrectFrame(1, 0, 3, 4))
+		})
+
+		t.Run("should_reject_frames_missed_by_late_player", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			game.NextFrame()
+			game.NextFrame()
+			require.NoError(t, game.AddPlayer("thuy", lo.ToPtr(150)))
+			require.NoError(t, game.AddPlayer("lan", nil))
+
+			assert.Error(t, game.CorrectFrame(1, 0, 10))
+			assert.Error(t, game.CorrectFrame(2, 1, 10))
+			player := game.GetPlayers()[1]
+			assert.Empty(t, player.GetFrameResults()[0])
+			assert.Equal(t, []int{15, 15}, player.GetScores()[:2])
+		})
+
+		t.Run("should_rescore_previous_frames", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			require.NoError(t, game.SetFrameResult(0, 10))
+			game.NextFrame()
+			require.NoError(t, game.SetFrameResult(0, 3, 4))
+			game.NextFrame()
+
+			err := game.CorrectFrame(0, 1, 5, 5)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []int{20, 10}, game.GetPlayers()[0].GetScores()[:2])
+			assert.Equal(t, 2, game.GetCurrentFrame(), "current frame should not change")
+		})
+	})
+
+	t.Run("CorrectFramePins", func(t *testing.T) {
+		t.Run("should_keep_pin_masks_and_splits", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			require.NoError(t, game.SetFrameLeaves(0, pins(7, 10), pins(7)))
+			game.NextFrame()
+
+			err := game.CorrectFramePins(0, 0, tenPinRules.rackMask()&^pins(7, 10), pins(7, 10))
+
+			assert.NoError(t, err)
+			frame := game.GetPlayers()[0].frames[0]
+			assert.Equal(t, []int{8, 2}, frame.GetPins())
+			assert.Equal(t, []PinMask{pins(7, 10), 0}, frame.GetLeaves())
+			assert.Equal(t, []Split{{Roll: 0, Leave: pins(7, 10), Converted: true}}, frame.GetSplits())
+		})
+
+		t.Run("should_reject_frame_not_reached", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			assert.Error(t, game.CorrectFramePins(0, 1, tenPinRules.rackMask()))
+		})
+	})
+
+	t.Run("CorrectFrameLeaves", func(t *testing.T) {
+		t.Run("should_correct_with_leaves", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			require.NoError(t, game.SetFrameResult(0, 3, 4))
+
+			err := game.CorrectFrameLeaves(0, 0, pins(4, 6), 0)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []int{8, 2}, game.GetPlayers()[0].frames[0].GetPins())
+			assert.Equal(t, []Split{{Roll: 0, Leave: pins(4, 6), Converted: true}}, game.GetPlayers()[0].frames[0].GetSplits())
+		})
+	})
+
+	t.Run("IsFinished", func(t *testing.T) {
+		t.Run("should_finish_when_all_players_complete_last_frame", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung", "thuy"}))
+			for _, e := range game.players {
+				e.currentFrame = 9
+			}
+
+			require.NoError(t, game.SetFrameResult(0, 3, 4))
+			assert.False(t, game.IsFinished(), "second player is not done")
+
+			require.NoError(t, game.SetFrameResult(1, 10, 10, 10))
+			assert.True(t, game.IsFinished())
+		})
 	})
 
 	t.Run("SetFrameScore", func(t *testing.T) {
@@ -103,7 +477,7 @@ func TestTenPinGame(t *testing.T) {
 				game := &TenPinGame{}
 				err := game.StartGame([]string{"hung"})
 				require.NoError(t, err)
-				game.currentFrame = 0
+				game.players[0].currentFrame = 0
 
 				err = game.SetFrameResult(0, 7)
 				assert.NotNil(t, err, "non-strike frame require 2 scores")
@@ -116,7 +490,7 @@ func TestTenPinGame(t *testing.T) {
 				game := &TenPinGame{}
 				err := game.StartGame([]string{"hung"})
 				require.NoError(t, err)
-				game.currentFrame = 9
+				game.players[0].currentFrame = 9
 
 				err = game.SetFrameResult(0, 2, 2, 3)
 				assert.NotNil(t, err, "open frame require 2 scores")
@@ -132,12 +506,12 @@ func TestTenPinGame(t *testing.T) {
 			game := &TenPinGame{}
 			err := game.StartGame([]string{"hung"})
 			require.NoError(t, err)
-			game.currentFrame = 0
+			game.players[0].currentFrame = 0
 
 			err = game.SetFrameResult(0, 10)
 
 			assert.NoError(t, err, "should return success")
-			rolls := game.GetPlayers()[0].frames[game.currentFrame].GetPins()
+			rolls := game.GetPlayers()[0].frames[game.players[0].currentFrame].GetPins()
 			assert.Equal(t, []int{numPin}, rolls, "should record a single roll with all pins knocked down")
 		})
 
@@ -145,12 +519,12 @@ func TestTenPinGame(t *testing.T) {
 			game := &TenPinGame{}
 			err := game.StartGame([]string{"hung"})
 			require.NoError(t, err)
-			game.currentFrame = 1
+			game.players[0].currentFrame = 1
 
 			err = game.SetFrameResult(0, 4, 6)
 
 			assert.NoError(t, err, "should return success")
-			rolls := game.GetPlayers()[0].frames[game.currentFrame].GetPins()
+			rolls := game.GetPlayers()[0].frames[game.players[0].currentFrame].GetPins()
 			expected := []int{4, numPin - 4}
 			assert.Equal(t, expected, rolls, "should record two rolls summing to all pins")
 		})
@@ -159,12 +533,12 @@ func TestTenPinGame(t *testing.T) {
 			game := &TenPinGame{}
 			err := game.StartGame([]string{"hung"})
 			require.NoError(t, err)
-			game.currentFrame = 1
+			game.players[0].currentFrame = 1
 
 			err = game.SetFrameResult(0, 3, 5)
 
 			assert.NoError(t, err, "should return success")
-			rolls := game.GetPlayers()[0].frames[game.currentFrame].GetPins()
+			rolls := game.GetPlayers()[0].frames[game.players[0].currentFrame].GetPins()
 			expected := []int{3, 5}
 			assert.Equal(t, expected, rolls, "open frame should record the provided two roll scores")
 		})
@@ -173,11 +547,11 @@ func TestTenPinGame(t *testing.T) {
 			game := &TenPinGame{}
 			err := game.StartGame([]string{"hung"})
 			require.NoError(t, err)
-			game.currentFrame = 9
+			game.players[0].currentFrame = 9
 
 			err = game.SetFrameResult(0, 10, 7, 2)
 			assert.NoError(t, err, "should return success")
-			rolls := game.GetPlayers()[0].frames[game.currentFrame].GetPins()
+			rolls := game.GetPlayers()[0].frames[game.players[0].currentFrame].GetPins()
 			expected := []int{numPin, 7, 2}
 			assert.Equal(t, expected, rolls, "should record three rolls")
 		})
@@ -186,11 +560,11 @@ func TestTenPinGame(t *testing.T) {
 			game := &TenPinGame{}
 			err := game.StartGame([]string{"hung"})
 			require.NoError(t, err)
-			game.currentFrame = 9
+			game.players[0].currentFrame = 9
 
 			err = game.SetFrameResult(0, 6, 4, 8)
 			assert.NoError(t, err, "should return success")
-			rolls := game.GetPlayers()[0].frames[game.currentFrame].GetPins()
+			rolls := game.GetPlayers()[0].frames[game.players[0].currentFrame].GetPins()
 			expected := []int{6, 4, 8}
 			assert.Equal(t, expected, rolls, "should record three rolls (including bonus)")
 		})
@@ -199,14 +573,162 @@ func TestTenPinGame(t *testing.T) {
 			game := &TenPinGame{}
 			err := game.StartGame([]string{"hung"})
 			require.NoError(t, err)
-			game.currentFrame = 9
+			game.players[0].currentFrame = 9
 
 			err = game.SetFrameResult(0, 3, 6)
 			assert.NoError(t, err)
-			rolls := game.GetPlayers()[0].frames[game.currentFrame].GetPins()
+			rolls := game.GetPlayers()[0].frames[game.players[0].currentFrame].GetPins()
 			expected := []int{3, 6}
 			assert.Equal(t, expected, rolls, "should record two rolls")
 		})
+
+		t.Run("normal_frame_with_leaves", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			sevenTenSplit := PinMask(1<<6 | 1<<9)
+
+			err := game.SetFrameLeaves(0, sevenTenSplit, 0)
+
+			assert.NoError(t, err)
+			frame := game.GetPlayers()[0].frames[0]
+			assert.Equal(t, []int{8, 2}, frame.GetPins())
+			assert.Equal(t, []PinMask{tenPinRules.rackMask() &^ sevenTenSplit, sevenTenSplit}, frame.GetPinMasks())
+			assert.Equal(t, []PinMask{sevenTenSplit, 0}, frame.GetLeaves())
+		})
+
+		t.Run("last_frame_with_leaves_after_strike", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			game.players[0].currentFrame = 9
+
+			err := game.SetFrameLeaves(0, 0, 1<<9, 0)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []int{10, 9, 1}, game.GetPlayers()[0].frames[9].GetPins(), "rack should be reset after strike")
+		})
+
+		t.Run("should_reject_leaves_with_pins_already_down", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			assert.Error(t, game.SetFrameLeaves(0, 1<<9, 1<<6))
+		})
+
+		t.Run("should_keep_leaves_empty_with_numbers_of_pins", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			require.NoError(t, game.SetFrameResult(0, 8, 2))
+
+			assert.Nil(t, game.GetPlayers()[0].frames[0].GetLeaves())
+			assert.Nil(t, game.GetPlayers()[0].GetFrameLeaves())
+		})
+	})
+
+	t.Run("Roll", func(t *testing.T) {
+		t.Run("should_reject_invalid_player_index", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			_, err := game.Roll(1, 5)
+
+			assert.Error(t, err)
+		})
+
+		t.Run("should_complete_open_frame_after_2_rolls", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			complete, err := game.Roll(0, 3)
+			require.NoError(t, err)
+			assert.False(t, complete)
+
+			complete, err = game.Roll(0, 4)
+			require.NoError(t, err)
+			assert.True(t, complete)
+			assert.Equal(t, []int{3, 4}, game.GetPlayers()[0].frames[0].GetPins())
+		})
+
+		t.Run("should_complete_frame_after_strike", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			complete, err := game.Roll(0, 10)
+
+			assert.NoError(t, err)
+			assert.True(t, complete)
+			_, err = game.Roll(0, 1)
+			assert.Error(t, err, "frame is complete")
+		})
+
+		t.Run("should_reject_more_than_standing_pins", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			_, err := game.Roll(0, 6)
+			require.NoError(t, err)
+
+			_, err = game.Roll(0, 5)
+
+			assert.Error(t, err)
+			assert.Equal(t, []int{6}, game.GetPlayers()[0].frames[0].GetPins(), "invalid roll should not be added")
+		})
+
+		t.Run("should_roll_fill_balls_in_last_frame", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			game.players[0].currentFrame = 9
+
+			var completes []bool
+			for _, pins := range []int{7, 3, 10} {
+				complete, err := game.Roll(0, pins)
+				require.NoError(t, err)
+				completes = append(completes, complete)
+			}
+
+			assert.Equal(t, []bool{false, false, true}, completes)
+			assert.Equal(t, []int{20}, game.GetPlayers()[0].GetScores()[9:])
+		})
+
+		t.Run("should_score_partial_frame", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			require.NoError(t, game.SetFrameResult(0, 10))
+			game.NextFrame()
+
+			_, err := game.Roll(0, 4)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []int{14, 4}, game.GetPlayers()[0].GetScores()[:2])
+		})
+	})
+
+	t.Run("RollPins", func(t *testing.T) {
+		t.Run("should_keep_pin_masks_of_frame", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			rack := tenPinRules.rackMask()
+
+			_, err := game.RollPins(0, rack&^pins(7, 10))
+			require.NoError(t, err)
+			complete, err := game.RollPins(0, pins(7, 10))
+
+			require.NoError(t, err)
+			assert.True(t, complete)
+			frame := game.GetPlayers()[0].frames[0]
+			assert.Equal(t, []int{8, 2}, frame.GetPins())
+			assert.Equal(t, []Split{{Roll: 0, Leave: pins(7, 10), Converted: true}}, frame.GetSplits())
+		})
+
+		t.Run("should_reject_frame_started_with_numbers_of_pins", func(t *testing.T) {
+			game := &TenPinGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			_, err := game.Roll(0, 8)
+			require.NoError(t, err)
+
+			_, err = game.RollPins(0, pins(7, 10))
+
+			assert.Error(t, err)
+		})
 	})
 }
 
@@ -268,6 +790,31 @@ func TestPlayer(t *testing.T) {
 		assert.Equal(t, expected, player.GetScores())
 	})
 
+	t.Run("perfect_game_with_current_frame_scoring", func(t *testing.T) {
+		rules := tenPinRules
+		rules.ScoringSystem = configs.CurrentFrame
+		player := newPlayer("max", &rules)
+		for i := 0; i < 10; i++ {
+			require.NoError(t, player.frames[i].KnockPins(10))
+		}
+
+		expected := []int{30, 30, 30, 30, 30, 30, 30, 30, 30, 30}
+		assert.Equal(t, expected, player.GetScores(), "perfect game should yield 300 total")
+	})
+
+	t.Run("game_with_strike_spare_and_current_frame_scoring", func(t *testing.T) {
+		rules := tenPinRules
+		rules.ScoringSystem = configs.CurrentFrame
+		player := newPlayer("spare", &rules)
+		require.NoError(t, player.frames[0].KnockPins(10))
+		require.NoError(t, player.frames[1].KnockPins(9, 1))
+		require.NoError(t, player.frames[2].KnockPins(8, 1))
+		require.NoError(t, player.frames[3].KnockPins(10))
+
+		expected := []int{30, 19, 9, 30, 0, 0, 0, 0, 0, 0}
+		assert.Equal(t, expected, player.GetScores(), "frames should be scored without bonus from next frames")
+	})
+
 	t.Run("complete_game_with_strike_spare", func(t *testing.T) {
 		player := NewPlayer("spare")
 		player.frames[0].KnockPins(10)
@@ -283,4 +830,125 @@ func TestPlayer(t *testing.T) {
 		expected := []int{20, 18, 9, 20, 20, 15, 8, 20, 20, 18}
 		assert.Equal(t, expected, player.GetScores())
 	})
+
+	t.Run("GetResolvedFrames", func(t *testing.T) {
+		t.Run("strike_is_pending_until_bonus_balls_are_rolled", func(t *testing.T) {
+			player := NewPlayer("hung")
+			require.NoError(t, player.frames[0].KnockPins(10))
+			player.currentFrame = 1
+			require.NoError(t, player.frames[1].Roll(3))
+
+			assert.Equal(t, []bool{false, false}, player.GetResolvedFrames()[:2])
+
+			require.NoError(t, player.frames[1].Roll(4))
+
+			assert.Equal(t, []bool{true, true, false}, player.GetResolvedFrames()[:3])
+			assert.Equal(t, []*int{lo.ToPtr(17), lo.ToPtr(24), nil}, player.GetCumulativeScores()[:3])
+		})
+
+		t.Run("skipped_frame_is_resolved", func(t *testing.T) {
+			player := NewPlayer("hung")
+			player.currentFrame = 1
+
+			assert.Equal(t, []bool{true, false}, player.GetResolvedFrames()[:2])
+			assert.Equal(t, []*int{lo.ToPtr(0), nil}, player.GetCumulativeScores()[:2])
+		})
+
+		t.Run("cumulative_scores_stop_at_first_pending_frame", func(t *testing.T) {
+			player := NewPlayer("hung")
+			require.NoError(t, player.frames[0].KnockPins(3, 4))
+			require.NoError(t, player.frames[1].KnockPins(6, 4))
+			player.currentFrame = 2
+
+			assert.Equal(t, []bool{true, false, false}, player.GetResolvedFrames()[:3])
+			assert.Equal(t, []*int{lo.ToPtr(7), nil, nil}, player.GetCumulativeScores()[:3])
+		})
+
+		t.Run("current_frame_scoring_resolves_strike_immediately", func(t *testing.T) {
+			rules := tenPinRules
+			rules.ScoringSystem = configs.CurrentFrame
+			player := newPlayer("hung", &rules)
+			require.NoError(t, player.frames[0].KnockPins(10))
+
+			assert.Equal(t, []bool{true, false}, player.GetResolvedFrames()[:2])
+			assert.Equal(t, lo.ToPtr(30), player.GetCumulativeScores()[0])
+		})
+
+		t.Run("perfect_game_is_resolved", func(t *testing.T) {
+			player := NewPlayer("max")
+			for i := 0; i < 9; i++ {
+				require.NoError(t, player.frames[i].KnockPins(10))
+			}
+			require.NoError(t, player.frames[9].KnockPins(10, 10, 10))
+
+			assert.Equal(t, lo.Times(10, func(int) bool { return true }), player.GetResolvedFrames())
+			assert.Equal(t, lo.ToPtr(300), player.GetCumulativeScores()[9])
+		})
+	})
+
+	t.Run("GetMaxScore_and_GetMinScore", func(t *testing.T) {
+		t.Run("new_game", func(t *testing.T) {
+			player := NewPlayer("hung")
+
+			assert.Equal(t, 300, player.GetMaxScore())
+			assert.Equal(t, 0, player.GetMinScore())
+			assert.Nil(t, player.GetProjectedScore())
+		})
+
+		t.Run("frame_in_progress", func(t *testing.T) {
+			player := NewPlayer("hung")
+			require.NoError(t, player.frames[0].KnockPins(10))
+			player.currentFrame = 1
+			require.NoError(t, player.frames[1].Roll(7))
+
+			assert.Equal(t, 10+7+3+20+270-30, player.GetMaxScore(), "spare in frame 2, then strikes")
+			assert.Equal(t, 17+7, player.GetMinScore())
+		})
+
+		t.Run("skipped_frame_is_not_played_again", func(t *testing.T) {
+			player := NewPlayer("hung")
+			player.currentFrame = 1
+
+			assert.Equal(t, 270, player.GetMaxScore())
+		})
+
+		t.Run("complete_game", func(t *testing.T) {
+			player := NewPlayer("hung")
+			for i := 0; i < 9; i++ {
+				require.NoError(t, player.frames[i].KnockPins(3, 4))
+			}
+			require.NoError(t, player.frames[9].KnockPins(3, 4))
+			player.currentFrame = 9
+
+			assert.Equal(t, 70, player.GetMaxScore())
+			assert.Equal(t, 70, player.GetMinScore())
+			assert.Equal(t, lo.ToPtr(70), player.GetProjectedScore())
+		})
+
+		t.Run("five_pin_game", func(t *testing.T) {
+			player := newPlayer("hung", &fivePinRules)
+
+			assert.Equal(t, 450, player.GetMaxScore())
+		})
+	})
+
+	t.Run("GetProjectedScore", func(t *testing.T) {
+		t.Run("should_project_average_of_resolved_frames", func(t *testing.T) {
+			player := NewPlayer("hung")
+			require.NoError(t, player.frames[0].KnockPins(3, 4))
+			require.NoError(t, player.frames[1].KnockPins(5, 4))
+			player.currentFrame = 2
+
+			assert.Equal(t, lo.ToPtr(80), player.GetProjectedScore())
+		})
+
+		t.Run("should_not_project_below_min_score", func(t *testing.T) {
+			player := NewPlayer("hung")
+			require.NoError(t, player.frames[0].KnockPins(1, 0))
+			player.currentFrame = 1
+			require.NoError(t, player.frames[1].KnockPins(10))
+
+			assert.Equal(t, lo.ToPtr(11), player.GetProjectedScore())
+		})
+	})
 }
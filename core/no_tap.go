@@ -0,0 +1,35 @@
+package core
+
+import (
+	"errors"
+
+	"bowling-score-tracker/configs"
+)
+
+const defaultTap = 9
+
+// NoTapGame implements the rule of no-tap 10-pin bowling,
+// where knocking at least the tap threshold (8 or 9) pins with the first ball of a rack counts as a strike.
+// A no-tap strike is scored as 10 pins, including when it is a bonus roll of a previous frame.
+type NoTapGame struct {
+	TenPinGame
+	// tap is the tap threshold. Default to 9 when it is not set.
+	tap int
+}
+
+func (n *NoTapGame) GetGameType() configs.GameType {
+	return configs.TenPinNoTap
+}
+
+func (n *NoTapGame) StartGame(playerNames []string) error {
+	if n.tap == 0 {
+		n.tap = defaultTap
+	}
+	if n.tap != 8 && n.tap != 9 {
+		return errors.New("tap threshold must be 8 or 9")
+	}
+
+	rules := tenPinRules
+	rules.TapThreshold = n.tap
+	return n.startGame(playerNames, rules)
+}
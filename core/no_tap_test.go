@@ -0,0 +1,79 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNoTapGame(t *testing.T) {
+	t.Run("StartGame", func(t *testing.T) {
+		t.Run("should_default_tap_threshold_to_9", func(t *testing.T) {
+			game := &NoTapGame{}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			assert.Equal(t, 9, game.GetRules().TapThreshold)
+		})
+
+		t.Run("should_reject_invalid_tap_threshold", func(t *testing.T) {
+			game := &NoTapGame{tap: 7}
+			assert.Error(t, game.StartGame([]string{"hung"}))
+		})
+	})
+
+	t.Run("SetFrameResult", func(t *testing.T) {
+		t.Run("normal_frame_strike_with_tap", func(t *testing.T) {
+			game := &NoTapGame{tap: 9}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			assert.Error(t, game.SetFrameResult(0, 9, 1), "strike frame require 1 ball")
+			assert.NoError(t, game.SetFrameResult(0, 9))
+			assert.Equal(t, []int{9}, game.GetPlayers()[0].frames[0].GetPins())
+		})
+
+		t.Run("should_not_count_below_tap_as_strike", func(t *testing.T) {
+			game := &NoTapGame{tap: 9}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+
+			assert.Error(t, game.SetFrameResult(0, 8))
+			assert.NoError(t, game.SetFrameResult(0, 8, 2))
+		})
+
+		t.Run("last_frame_strikes_with_tap", func(t *testing.T) {
+			game := &NoTapGame{tap: 8}
+			require.NoError(t, game.StartGame([]string{"hung"}))
+			game.players[0].currentFrame = 9
+
+			assert.Error(t, game.SetFrameResult(0, 8, 1), "strike frame require 3 balls")
+			assert.NoError(t, game.SetFrameResult(0, 8, 9, 10))
+		})
+	})
+
+	t.Run("GetScores", func(t *testing.T) {
+		t.Run("perfect_game_with_tap", func(t *testing.T) {
+			rules := tenPinRules
+			rules.TapThreshold = 9
+			player := newPlayer("max", &rules)
+			for i := 0; i < 9; i++ {
+				require.NoError(t, player.frames[i].KnockPins(9))
+			}
+			require.NoError(t, player.frames[9].KnockPins(9, 10, 9))
+
+			expected := []int{30, 30, 30, 30, 30, 30, 30, 30, 30, 30}
+			assert.Equal(t, expected, player.GetScores())
+		})
+
+		t.Run("tap_strike_counts_as_10_in_bonus", func(t *testing.T) {
+			rules := tenPinRules
+			rules.TapThreshold = 9
+			player := newPlayer("spare", &rules)
+			require.NoError(t, player.frames[0].KnockPins(4, 6))
+			require.NoError(t, player.frames[1].KnockPins(9))
+			require.NoError(t, player.frames[2].KnockPins(8, 1))
+
+			expected := []int{20, 19, 9, 0, 0, 0, 0, 0, 0, 0}
+			assert.Equal(t, expected, player.GetScores())
+		})
+	})
+}
@@ -0,0 +1,121 @@
+package core
+
+import (
+	"fmt"
+	"strconv"
+
+	"bowling-score-tracker/configs"
+)
+
+// GameTypeInfo contains the metadata of a game type, eg for a front-end to build its game type picker.
+type GameTypeInfo struct {
+	GameType    configs.GameType `json:"game_type"`
+	DisplayName string           `json:"display_name"`
+	MaxPlayers  int              `json:"max_players"`
+	NumFrames   int              `json:"num_frames"`
+	// NumPins is the number of pins in a rack. Pins are numbered from 1 when setting the knocked pins.
+	NumPins int `json:"num_pins"`
+	// PinNotation contains the symbols accepted in the pins of a frame result.
+	// It is empty when the frame result must be set with the knocked pins instead.
+	PinNotation []string `json:"pin_notation"`
+}
+
+// GameFactory creates a game of a game type. Options which don't apply to the game type are ignored.
+type GameFactory func(opts GameOptions) Game
+
+type registeredGameType struct {
+	info    GameTypeInfo
+	factory GameFactory
+}
+
+// pinCountNotation returns the notation of games where frame results are set with the numbers of pins knocked,
+// which has a digit for each number of pins below a strike
+func pinCountNotation(numPins int) []string {
+	res := []string{"X", "/", "-", "F"}
+	for i := 0; i < numPins; i++ {
+		res = append(res, strconv.Itoa(i))
+	}
+	return res
+}
+
+// gameTypes contains the registered game types in registration order
+var gameTypes []registeredGameType
+
+// register the built-in game types
+func init() {
+	RegisterGameType(newGameTypeInfo(configs.TenPin, "10-pin", tenPinRules), func(opts GameOptions) Game {
+		game := newTenPinGame(opts)
+		return &game
+	})
+	RegisterGameType(newGameTypeInfo(configs.TenPinNoTap, "10-pin no-tap", tenPinRules), func(opts GameOptions) Game {
+		return &NoTapGame{TenPinGame: newTenPinGame(opts), tap: opts.TapThreshold}
+	})
+	RegisterGameType(newGameTypeInfo(configs.Candlepin, "Candlepin", candlepinRules), func(opts GameOptions) Game {
+		return &CandlepinGame{TenPinGame: newTenPinGame(opts)}
+	})
+	RegisterGameType(newGameTypeInfo(configs.Duckpin, "Duckpin", duckpinRules), func(opts GameOptions) Game {
+		return &DuckpinGame{TenPinGame: newTenPinGame(opts)}
+	})
+	RegisterGameType(newGameTypeInfo(configs.FivePin, "5-pin", fivePinRules), func(opts GameOptions) Game {
+		return &FivePinGame{TenPinGame: newTenPinGame(opts)}
+	})
+	RegisterGameType(newGameTypeInfo(configs.Baker, "Baker", tenPinRules), func(opts GameOptions) Game {
+		return &BakerGame{TenPinGame: newTenPinGame(opts), order: opts.BakerOrder}
+	})
+	RegisterGameType(newGameTypeInfo(configs.ScotchDoubles, "Scotch doubles", tenPinRules), func(opts GameOptions) Game {
+		return &ScotchDoublesGame{baseGame: newBaseGame(opts), partners: opts.Partners}
+	})
+	// the rules of a custom game are set when starting the game, so its metadata is empty
+	RegisterGameType(GameTypeInfo{GameType: configs.Custom, DisplayName: "Custom"}, func(opts GameOptions) Game {
+		return newCustomGame(configs.Custom, opts.Rules, opts)
+	})
+}
+
+// newGameTypeInfo creates the metadata of a game type played with the given rules
+func newGameTypeInfo(t configs.GameType, displayName string, rules Rules) GameTypeInfo {
+	info := GameTypeInfo{
+		GameType:    t,
+		DisplayName: displayName,
+		MaxPlayers:  rules.MaxPlayers,
+		NumFrames:   rules.NumFrames,
+		NumPins:     rules.NumPins,
+	}
+	if len(rules.PinValues) == 0 {
+		info.PinNotation = pinCountNotation(rules.NumPins)
+	}
+	return info
+}
+
+// RegisterGameType makes a game type available to GameManager.
+// It panics if the game type is registered twice, or if factory is nil.
+func RegisterGameType(info GameTypeInfo, factory GameFactory) {
+	if factory == nil {
+		panic("game factory is nil")
+	}
+	if _, ok := getGameType(info.GameType); ok {
+		panic(fmt.Sprintf("game type %s is registered twice", info.GameType))
+	}
+
+	gameTypes = append(gameTypes, registeredGameType{
+		info:    info,
+		factory: factory,
+	})
+}
+
+// GetGameTypes returns the metadata of all registered game types
+func GetGameTypes() []GameTypeInfo {
+	var res []GameTypeInfo
+	for _, e := range gameTypes {
+		res = append(res, e.info)
+	}
+	return res
+}
+
+func getGameType(t configs.GameType) (registeredGameType, bool) {
+	for _, e := range gameTypes {
+		if e.info.GameType == t {
+			return e, true
+		}
+	}
+	return registeredGameType{}, false
+}
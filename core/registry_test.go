@@ -0,0 +1,59 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"bowling-score-tracker/configs"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Run("GetGameTypes", func(t *testing.T) {
+		t.Run("should_return_built_in_game_types", func(t *testing.T) {
+			var types []configs.GameType
+			for _, e := range GetGameTypes() {
+				types = append(types, e.GameType)
+			}
+
+			builtIn := []configs.GameType{
+				configs.TenPin, configs.TenPinNoTap, configs.Candlepin, configs.Duckpin, configs.FivePin, configs.Baker, configs.ScotchDoubles,
+				configs.Custom,
+			}
+			assert.Equal(t, builtIn, types[:len(builtIn)], "built-in game types should be registered first")
+		})
+
+		t.Run("should_list_foul_in_pin_notation", func(t *testing.T) {
+			assert.Contains(t, GetGameTypes()[0].PinNotation, "F")
+			assert.Empty(t, newGameTypeInfo(configs.FivePin, "5-pin", fivePinRules).PinNotation)
+		})
+
+		t.Run("should_list_numbers_of_pins_of_rack_in_pin_notation", func(t *testing.T) {
+			rules := tenPinRules
+			rules.NumPins = 12
+
+			notation := newGameTypeInfo("TWELVE_PIN", "12-pin", rules).PinNotation
+
+			assert.Contains(t, notation, "X")
+			assert.Contains(t, notation, "11")
+			assert.NotContains(t, notation, "12")
+			assert.NotContains(t, GetGameTypes()[0].PinNotation, "10")
+		})
+	})
+
+	t.Run("RegisterGameType", func(t *testing.T) {
+		t.Run("should_panic_when_game_type_is_registered_twice", func(t *testing.T) {
+			assert.Panics(t, func() {
+				RegisterGameType(GameTypeInfo{GameType: configs.TenPin}, func(opts GameOptions) Game {
+					return &TenPinGame{}
+				})
+			})
+		})
+
+		t.Run("should_panic_when_factory_is_nil", func(t *testing.T) {
+			assert.Panics(t, func() {
+				RegisterGameType(GameTypeInfo{GameType: "abc"}, nil)
+			})
+		})
+	})
+}
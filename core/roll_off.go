@@ -0,0 +1,163 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/samber/lo"
+
+	"bowling-score-tracker/configs"
+)
+
+// rollOff links a roll-off game to the tied players of its parent game
+type rollOff struct {
+	gameId   int32
+	parentId int32
+	format   configs.RollOffFormat
+	// place is the place of the tied players in the parent game
+	place int
+	// playerIndexes contains the indexes of the players of the roll-off in the parent game, in the same order
+	playerIndexes []int
+}
+
+// RollOffInfo contains the state of a roll-off of a game
+type RollOffInfo struct {
+	GameId        int32                 `json:"game_id"`
+	Format        configs.RollOffFormat `json:"format"`
+	Place         int                   `json:"place"`
+	PlayerIndexes []int                 `json:"player_indexes"`
+	Status        configs.GameStatus    `json:"status"`
+}
+
+// rollOffRules returns the rules of a roll-off of a game played with the given rules
+func rollOffRules(rules Rules, format configs.RollOffFormat, numPlayers int) (Rules, error) {
+	rules.MaxPlayers = numPlayers
+	switch format {
+	case configs.NinthTenthFrame:
+		rules.NumFrames = 2
+	case configs.OneBall:
+		rules.NumFrames = 1
+		rules.BallsPerFrame = 1
+		rules.StrikeBonusBalls = 0
+		rules.SpareBonusBalls = 0
+		rules.StrikeFillBalls = 0
+		rules.SpareFillBalls = 0
+	default:
+		return rules, errors.New("roll-off format is not supported")
+	}
+	return rules, nil
+}
+
+// StartRollOff starts a roll-off between the players tied at a place of a completed game.
+// The roll-off is played as a separate game, and its standings break the tie in the standings of the parent game
+// once it is completed. Another roll-off can be started on the parent game if the players are tied again,
+// so a roll-off can't be started on a roll-off game.
+func (m *GameManager) StartRollOff(gameId int32, place int, format configs.RollOffFormat) (g GameInfo, err error) {
+	game := m.GameById[gameId]
+	if game == nil {
+		return g, errors.New("invalid game id")
+	}
+	if game.GetGameType() == configs.RollOff {
+		return g, errors.New("a tie in a roll-off is broken by another roll-off of its parent game")
+	}
+
+	tied := lo.Filter(m.getStandings(gameId, game), func(item Standing, index int) bool {
+		return item.Place == place
+	})
+	if len(tied) < 2 {
+		return g, fmt.Errorf("there is no tie at place %d of a completed game", place)
+	}
+	for _, e := range m.rollOffsByParentId[gameId] {
+		if e.place == place && m.GameById[e.gameId].GetStatus() == configs.InProgress {
+			return g, fmt.Errorf("roll-off %d is in progress for place %d", e.gameId, place)
+		}
+	}
+
+	rules, err := rollOffRules(game.GetRules(), format, len(tied))
+	if err != nil {
+		return g, err
+	}
+	names := lo.Map(tied, func(item Standing, index int) string {
+		return item.Name
+	})
+	opts := GameOptions{ScoringSystem: game.GetScoringSystem()}
+	rollOffId, rollOffGame, err := m.addGame(func() (Game, error) {
+		game := newCustomGame(configs.RollOff, &rules, opts)
+		return game, game.StartGame(names)
+	})
+	if err != nil {
+		return g, err
+	}
+
+	r := &rollOff{
+		gameId:   rollOffId,
+		parentId: gameId,
+		format:   format,
+		place:    place,
+		playerIndexes: lo.Map(tied, func(item Standing, index int) int {
+			return item.PlayerIndex
+		}),
+	}
+	m.rollOffsByParentId[gameId] = append(m.rollOffsByParentId[gameId], r)
+	m.rollOffById[rollOffId] = r
+	return m.toGameInfo(rollOffId, rollOffGame), nil
+}
+
+// getStandings returns the standings of a game, where ties are broken by its completed roll-offs
+func (m *GameManager) getStandings(gameId int32, game Game) []Standing {
+	res := game.GetStandings()
+	for _, e := range m.rollOffsByParentId[gameId] {
+		res = applyRollOff(res, e, m.GameById[e.gameId].GetStandings())
+	}
+	return res
+}
+
+// applyRollOff breaks the tie between the players of a roll-off with the standings of the roll-off.
+// The standings don't change if the roll-off isn't completed, or if its players are no longer tied, eg after a correction.
+func applyRollOff(standings []Standing, r *rollOff, rollOffStandings []Standing) []Standing {
+	if len(rollOffStandings) == 0 {
+		return standings
+	}
+	var tied []int
+	for _, e := range standings {
+		if e.Place == r.place {
+			tied = append(tied, e.PlayerIndex)
+		}
+	}
+	if !slices.Equal(tied, r.playerIndexes) {
+		return standings
+	}
+
+	placeByPlayer := map[int]int{}
+	for _, e := range rollOffStandings {
+		placeByPlayer[r.playerIndexes[e.PlayerIndex]] = r.place + e.Place - 1
+	}
+
+	res := slices.Clone(standings)
+	for i := range res {
+		if place, ok := placeByPlayer[res[i].PlayerIndex]; ok {
+			res[i].Place = place
+		}
+		res[i].Winner = res[i].Place == 1
+	}
+	slices.SortStableFunc(res, func(a, b Standing) int {
+		return a.Place - b.Place
+	})
+	return res
+}
+
+func (m *GameManager) getRollOffs(gameId int32) []RollOffInfo {
+	if len(m.rollOffsByParentId[gameId]) == 0 {
+		return nil
+	}
+	return lo.Map(m.rollOffsByParentId[gameId], func(item *rollOff, index int) RollOffInfo {
+		return RollOffInfo{
+			GameId:        item.gameId,
+			Format:        item.format,
+			Place:         item.place,
+			PlayerIndexes: item.playerIndexes,
+			Status:        m.GameById[item.gameId].GetStatus(),
+		}
+	})
+}
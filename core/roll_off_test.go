@@ -0,0 +1,203 @@
+package core
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"bowling-score-tracker/configs"
+)
+
+// startTiedGame starts a 10-pin game where all players knock the same pins in each frame
+func startTiedGame(t *testing.T, m *GameManager, playerNames ...string) int32 {
+	res, err := m.StartGame(configs.TenPin, playerNames, GameOptions{})
+	require.NoError(t, err)
+	playGame(t, m, res.Id, slices.Repeat([][]int{{4, 5}}, len(playerNames))...)
+	return res.Id
+}
+
+// playGame completes a 10-pin game where each player knocks the same pins in all frames.
+// The fill ball of a spare in the last frame knocks no pin.
+func playGame(t *testing.T, m *GameManager, gameId int32, pinsByPlayer ...[]int) {
+	for i := 0; i < tenPinRules.NumFrames; i++ {
+		for j, pins := range pinsByPlayer {
+			if i == tenPinRules.NumFrames-1 && lo.Sum(pins) == numPin {
+				pins = append(pins, 0)
+			}
+			_, err := m.SetFrameResult(gameId, j, pins...)
+			require.NoError(t, err)
+		}
+		if i < tenPinRules.NumFrames-1 {
+			_, err := m.NextFrame(gameId)
+			require.NoError(t, err)
+		}
+	}
+}
+
+func TestRollOffRules(t *testing.T) {
+	t.Run("should_bowl_last_2_frames_in_ninth_tenth_frame_roll_off", func(t *testing.T) {
+		rules, err := rollOffRules(tenPinRules, configs.NinthTenthFrame, 2)
+
+		require.NoError(t, err)
+		assert.NoError(t, rules.Validate())
+		assert.Equal(t, 2, rules.NumFrames)
+		assert.Equal(t, 2, rules.MaxPlayers)
+		assert.Equal(t, tenPinRules.StrikeFillBalls, rules.StrikeFillBalls)
+	})
+
+	t.Run("should_roll_a_single_ball_in_one_ball_roll_off", func(t *testing.T) {
+		rules, err := rollOffRules(tenPinRules, configs.OneBall, 3)
+
+		require.NoError(t, err)
+		assert.NoError(t, rules.Validate())
+		assert.Equal(t, 1, rules.NumFrames)
+		assert.Equal(t, 1, rules.BallsPerFrame)
+		assert.Equal(t, 0, rules.StrikeBonusBalls+rules.SpareBonusBalls+rules.StrikeFillBalls+rules.SpareFillBalls)
+	})
+
+	t.Run("should_reject_unsupported_format", func(t *testing.T) {
+		_, err := rollOffRules(tenPinRules, "abc", 2)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestGameManager_StartRollOff(t *testing.T) {
+	t.Run("should_reject_invalid_game_id", func(t *testing.T) {
+		m := NewGameManager()
+
+		_, err := m.StartRollOff(1, 1, configs.OneBall)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should_reject_game_in_progress", func(t *testing.T) {
+		m := NewGameManager()
+		startGameRes, err := m.StartGame(configs.TenPin, []string{"hung", "thuy"}, GameOptions{})
+		require.NoError(t, err)
+
+		_, err = m.StartRollOff(startGameRes.Id, 1, configs.OneBall)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should_reject_place_without_tie", func(t *testing.T) {
+		m := NewGameManager()
+		gameId := startTiedGame(t, m, "hung", "thuy")
+
+		_, err := m.StartRollOff(gameId, 2, configs.OneBall)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should_start_roll_off_with_tied_players", func(t *testing.T) {
+		m := NewGameManager()
+		gameId := startTiedGame(t, m, "hung", "thuy")
+
+		res, err := m.StartRollOff(gameId, 1, configs.NinthTenthFrame)
+
+		require.NoError(t, err)
+		assert.Equal(t, configs.RollOff, res.GameType)
+		assert.Equal(t, gameId, res.ParentId)
+		assert.Equal(t, "hung", res.Players[0].Name)
+		assert.Equal(t, "thuy", res.Players[1].Name)
+		assert.Len(t, res.Players[0].Frames, 2)
+
+		parent, err := m.GetGame(gameId)
+		require.NoError(t, err)
+		assert.Equal(t, []RollOffInfo{{
+			GameId:        res.Id,
+			Format:        configs.NinthTenthFrame,
+			Place:         1,
+			PlayerIndexes: []int{0, 1},
+			Status:        configs.InProgress,
+		}}, parent.RollOffs)
+	})
+
+	t.Run("should_reject_roll_off_of_roll_off", func(t *testing.T) {
+		m := NewGameManager()
+		gameId := startTiedGame(t, m, "hung", "thuy")
+		rollOffRes, err := m.StartRollOff(gameId, 1, configs.OneBall)
+		require.NoError(t, err)
+		_, err = m.SetFrameResult(rollOffRes.Id, 0, 8)
+		require.NoError(t, err)
+		_, err = m.SetFrameResult(rollOffRes.Id, 1, 8)
+		require.NoError(t, err)
+
+		_, err = m.StartRollOff(rollOffRes.Id, 1, configs.OneBall)
+		assert.Error(t, err)
+
+		_, err = m.StartRollOff(gameId, 1, configs.OneBall)
+		assert.NoError(t, err, "the tie is broken by another roll-off of the parent game")
+	})
+
+	t.Run("should_reject_second_roll_off_in_progress", func(t *testing.T) {
+		m := NewGameManager()
+		gameId := startTiedGame(t, m, "hung", "thuy")
+		_, err := m.StartRollOff(gameId, 1, configs.OneBall)
+		require.NoError(t, err)
+
+		_, err = m.StartRollOff(gameId, 1, configs.OneBall)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should_break_tie_in_parent_standings", func(t *testing.T) {
+		m := NewGameManager()
+		gameId := startTiedGame(t, m, "hung", "thuy", "lan")
+		rollOffRes, err := m.StartRollOff(gameId, 1, configs.OneBall)
+		require.NoError(t, err)
+
+		_, err = m.SetFrameResult(rollOffRes.Id, 0, 8)
+		require.NoError(t, err)
+		_, err = m.SetFrameResult(rollOffRes.Id, 1, 10)
+		require.NoError(t, err)
+		_, err = m.SetFrameResult(rollOffRes.Id, 2, 8)
+		require.NoError(t, err)
+
+		res, err := m.GetGame(gameId)
+		require.NoError(t, err)
+		assert.Equal(t, []Standing{
+			{Place: 1, PlayerIndex: 1, Name: "thuy", TotalScore: 90, Winner: true},
+			{Place: 2, PlayerIndex: 0, Name: "hung", TotalScore: 90},
+			{Place: 2, PlayerIndex: 2, Name: "lan", TotalScore: 90},
+		}, res.Standings)
+		assert.Equal(t, configs.Completed, res.RollOffs[0].Status)
+
+		// the players are tied again at place 2, so another roll-off can be started between them
+		rollOffRes, err = m.StartRollOff(gameId, 2, configs.OneBall)
+		require.NoError(t, err)
+		assert.Equal(t, "hung", rollOffRes.Players[0].Name)
+		assert.Equal(t, "lan", rollOffRes.Players[1].Name)
+		_, err = m.SetFrameResult(rollOffRes.Id, 0, 7)
+		require.NoError(t, err)
+		_, err = m.SetFrameResult(rollOffRes.Id, 1, 9)
+		require.NoError(t, err)
+
+		res, err = m.GetGame(gameId)
+		require.NoError(t, err)
+		assert.Equal(t, []int{2, 0}, []int{res.Standings[1].PlayerIndex, res.Standings[2].PlayerIndex})
+		assert.Equal(t, []int{2, 3}, []int{res.Standings[1].Place, res.Standings[2].Place})
+	})
+
+	t.Run("should_ignore_roll_off_when_players_are_no_longer_tied", func(t *testing.T) {
+		m := NewGameManager()
+		gameId := startTiedGame(t, m, "hung", "thuy")
+		rollOffRes, err := m.StartRollOff(gameId, 1, configs.OneBall)
+		require.NoError(t, err)
+		_, err = m.SetFrameResult(rollOffRes.Id, 0, 8)
+		require.NoError(t, err)
+		_, err = m.SetFrameResult(rollOffRes.Id, 1, 10)
+		require.NoError(t, err)
+
+		res, err := m.CorrectFrame(gameId, "admin", 0, 0, 5, 5)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, res.Standings[0].PlayerIndex)
+		assert.Equal(t, 1, res.Standings[1].PlayerIndex)
+		assert.Equal(t, 2, res.Standings[1].Place)
+	})
+}
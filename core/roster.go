@@ -0,0 +1,176 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/samber/lo"
+)
+
+func (g *baseGame) AddPlayer(name string, blindScore *int) error {
+	if err := g.checkInProgress(); err != nil {
+		return err
+	}
+	rules := g.rules
+	if rules == nil {
+		return errors.New("game is not started")
+	}
+	if name == "" {
+		return errors.New("name is empty")
+	}
+	if len(g.teams) > 0 {
+		return errors.New("late players can't be added to team games")
+	}
+	if len(g.players) >= rules.MaxPlayers {
+		return fmt.Errorf("max num of players is %d", rules.MaxPlayers)
+	}
+
+	player := newPlayer(name, rules)
+	if blindScore != nil && (*blindScore < 0 || *blindScore > player.GetMaxScore()) {
+		return errors.New("invalid blind score")
+	}
+	player.currentFrame = g.GetCurrentFrame()
+	player.joinedFrame = player.currentFrame
+	for i := 0; i < player.currentFrame; i++ {
+		player.bowlers[i] = ""
+	}
+	if blindScore != nil {
+		player.missedScores = missedFrameScores(*blindScore, rules.NumFrames, player.currentFrame)
+	}
+	g.players = append(g.players, player)
+	return nil
+}
+
+// missedFrameScores prorates the blind score of a whole game over the frames missed before a frame,
+// so the running total at each missed frame is the blind score times the share of the game bowled
+func missedFrameScores(blindScore int, numFrames int, frameIndex int) []int {
+	res := make([]int, frameIndex)
+	for i := range res {
+		res[i] = blindScore*(i+1)/numFrames - blindScore*i/numFrames
+	}
+	return res
+}
+
+// getBowledScore returns the total score without the blind scores of the frames a late player missed,
+// which only count in the total of the player and in team totals
+func (p *Player) getBowledScore() int {
+	return p.GetTotalScore() - lo.Sum(p.missedScores)
+}
+
+func (g *baseGame) WithdrawPlayer(playerIndex int) error {
+	if err := g.checkInProgress(); err != nil {
+		return err
+	}
+	player, err := g.getActivePlayer(playerIndex)
+	if err != nil {
+		return err
+	}
+
+	// the frame in progress is credited to the bowler if they have already rolled in it
+	for i := player.firstUnbowledFrame(); i < len(player.bowlers); i++ {
+		player.bowlers[i] = ""
+	}
+	player.withdrawn = true
+	return nil
+}
+
+func (g *baseGame) SubstitutePlayer(playerIndex int, name string, fromFrame int) error {
+	if err := g.checkInProgress(); err != nil {
+		return err
+	}
+	player, err := g.getActivePlayer(playerIndex)
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		return errors.New("name is empty")
+	}
+	if fromFrame < 0 || fromFrame >= len(player.frames) {
+		return errors.New("invalid frame index")
+	}
+	if fromFrame < player.firstUnbowledFrame() {
+		return errors.New("frame has already been played")
+	}
+
+	for i := fromFrame; i < len(player.bowlers); i++ {
+		player.bowlers[i] = name
+	}
+	// the name of the player is the name of its current bowler, so it changes once the player reaches fromFrame
+	for frame := range player.substitutes {
+		if frame >= fromFrame {
+			delete(player.substitutes, frame)
+		}
+	}
+	if fromFrame == player.currentFrame {
+		player.name = name
+		return nil
+	}
+	if player.substitutes == nil {
+		player.substitutes = map[int]string{}
+	}
+	player.substitutes[fromFrame] = name
+	return nil
+}
+
+// GetBowlers returns the name of the bowler of each frame, which is empty when nobody bowled the frame.
+// It is used to credit the frames to the right bowler when players are added, withdrawn or substituted.
+func (p *Player) GetBowlers() []string {
+	return slices.Clone(p.bowlers)
+}
+
+// IsWithdrawn returns whether the player stopped before the end of the game
+func (p *Player) IsWithdrawn() bool {
+	return p.withdrawn
+}
+
+// BowlerStats contains the statistics of a bowler over the frames of a player credited to the bowler
+type BowlerStats struct {
+	Name string `json:"name"`
+	// Frames is the number of frames bowled
+	Frames int `json:"frames"`
+	// Strikes and Spares count the frames starting with a strike or a spare
+	Strikes int `json:"strikes"`
+	Spares  int `json:"spares"`
+	// Pins is the value of the pins knocked in the frames
+	Pins int `json:"pins"`
+	// Score is the sum of the scores of the frames, including their bonus
+	Score int `json:"score"`
+	// Fouls is the number of foul rolls
+	Fouls int `json:"fouls"`
+}
+
+// GetBowlerStats returns the statistics of each bowler of the player over the frames played,
+// in the order the bowlers first bowled
+func (p *Player) GetBowlerStats() []BowlerStats {
+	var res []BowlerStats
+	scores := p.GetScores()
+	for i, frame := range p.frames {
+		name := p.bowlers[i]
+		if name == "" || (!frame.IsComplete() && i >= p.currentFrame) {
+			continue
+		}
+		j := slices.IndexFunc(res, func(e BowlerStats) bool {
+			return e.Name == name
+		})
+		if j < 0 {
+			res = append(res, BowlerStats{Name: name})
+			j = len(res) - 1
+		}
+
+		scored := frame.GetScoredPins()
+		strike := len(scored) >= 1 && scored[0] >= p.rules.strikePins()
+		res[j].Frames++
+		if strike {
+			res[j].Strikes++
+		} else if len(scored) >= 2 && scored[0]+scored[1] == p.rules.rackValue() {
+			res[j].Spares++
+		}
+		for _, e := range scored {
+			res[j].Pins += e
+		}
+		res[j].Score += scores[i]
+		res[j].Fouls += len(frame.GetFouls())
+	}
+	return res
+}
@@ -0,0 +1,276 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"bowling-score-tracker/configs"
+)
+
+func TestTenPinGame_AddPlayer(t *testing.T) {
+	t.Run("should_add_player_at_current_frame_with_empty_earlier_frames", func(t *testing.T) {
+		game := &TenPinGame{}
+		require.NoError(t, game.StartGame([]string{"hung"}))
+		require.NoError(t, game.SetFrameResult(0, 10))
+		game.NextFrame()
+		game.NextFrame()
+
+		err := game.AddPlayer("thuy", nil)
+
+		require.NoError(t, err)
+		player := game.GetPlayers()[1]
+		assert.Equal(t, 2, player.GetCurrentFrame())
+		assert.Equal(t, []string{"", "", "thuy"}, player.GetBowlers()[:3])
+		assert.Equal(t, 0, lo.Sum(player.GetScores()))
+		assert.Equal(t, 2, game.GetCurrentFrame())
+	})
+
+	t.Run("should_score_earlier_frames_with_share_of_blind_score", func(t *testing.T) {
+		game := &TenPinGame{}
+		require.NoError(t, game.StartGame([]string{"hung"}))
+		game.NextFrame()
+		game.NextFrame()
+
+		err := game.AddPlayer("thuy", lo.ToPtr(125))
+
+		require.NoError(t, err)
+		player := game.GetPlayers()[1]
+		assert.Equal(t, []int{12, 13, 0}, player.GetScores()[:3])
+		assert.Equal(t, []*int{lo.ToPtr(12), lo.ToPtr(25), nil}, player.GetCumulativeScores()[:3])
+		assert.Empty(t, player.GetFrameResults()[0], "missed frames are not rolled")
+		_, err = game.Roll(1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 10, player.GetScores()[2], "missed frames don't earn bonus from the frames rolled after them")
+	})
+
+	t.Run("should_project_score_without_missed_frames", func(t *testing.T) {
+		game := &TenPinGame{}
+		require.NoError(t, game.StartGame([]string{"hung", "lan"}))
+		for i := 0; i < 5; i++ {
+			game.NextFrame()
+		}
+		require.NoError(t, game.AddPlayer("thuy", nil))
+		require.NoError(t, game.AddPlayer("mai", lo.ToPtr(100)))
+		require.NoError(t, game.SetFrameResult(2, 5, 4))
+		require.NoError(t, game.SetFrameResult(3, 5, 4))
+		game.NextFrame()
+
+		thuy := game.GetPlayers()[2]
+		assert.Equal(t, []bool{false, false, false, false, false, true, false}, thuy.GetResolvedFrames()[:7])
+		assert.Equal(t, []*int{nil, nil, nil, nil, nil, lo.ToPtr(9), nil}, thuy.GetCumulativeScores()[:7])
+		assert.Equal(t, lo.ToPtr(90), thuy.GetProjectedScore())
+		mai := game.GetPlayers()[3]
+		assert.Equal(t, lo.ToPtr(50+9), mai.GetCumulativeScores()[5])
+		assert.Equal(t, lo.ToPtr(50+9*5), mai.GetProjectedScore(), "blind scores of missed frames plus average of the frames bowled")
+	})
+
+	t.Run("should_reject_invalid_player", func(t *testing.T) {
+		game := &TenPinGame{}
+		require.NoError(t, game.StartGame([]string{"a", "b", "c", "d", "e"}))
+		game.NextFrame()
+
+		assert.Error(t, game.AddPlayer("f", nil), "max num of players")
+		game.players = game.players[:1]
+		assert.Error(t, game.AddPlayer("", nil), "empty name")
+		assert.Error(t, game.AddPlayer("f", lo.ToPtr(301)), "invalid blind score")
+		assert.Len(t, game.GetPlayers(), 1)
+	})
+}
+
+func TestTenPinGame_WithdrawPlayer(t *testing.T) {
+	t.Run("should_stop_player_and_let_others_finish_the_game", func(t *testing.T) {
+		game := &TenPinGame{}
+		require.NoError(t, game.StartGame([]string{"hung", "thuy"}))
+		require.NoError(t, game.SetFrameResult(0, 10))
+		require.NoError(t, game.SetFrameResult(1, 3, 4))
+		game.NextFrame()
+		_, err := game.Roll(1, 5)
+		require.NoError(t, err)
+
+		err = game.WithdrawPlayer(1)
+
+		require.NoError(t, err)
+		player := game.GetPlayers()[1]
+		assert.True(t, player.IsWithdrawn())
+		// the frame in progress was started by the player
+		assert.Equal(t, []string{"thuy", "thuy", ""}, player.GetBowlers()[:3])
+		assert.Equal(t, []bool{true, true, true}, player.GetResolvedFrames()[:3])
+		assert.Equal(t, 12, player.GetMaxScore())
+		assert.Error(t, game.SetFrameResult(1, 4))
+		assert.Error(t, game.WithdrawPlayer(1))
+
+		game.players[0].currentFrame = 9
+		require.NoError(t, game.SetFrameResult(0, 3, 4))
+		assert.Equal(t, configs.Completed, game.GetStatus())
+	})
+
+	t.Run("should_not_credit_frame_in_progress_without_rolls", func(t *testing.T) {
+		game := &TenPinGame{}
+		require.NoError(t, game.StartGame([]string{"hung", "thuy"}))
+		game.NextFrame()
+
+		require.NoError(t, game.WithdrawPlayer(0))
+
+		assert.Equal(t, []string{"hung", ""}, game.GetPlayers()[0].GetBowlers()[:2])
+		assert.Equal(t, 2, game.NextFrame(), "withdrawn players don't hold the game back")
+	})
+}
+
+func TestTenPinGame_SubstitutePlayer(t *testing.T) {
+	t.Run("should_credit_frames_from_frame_to_substitute", func(t *testing.T) {
+		game := &TenPinGame{}
+		require.NoError(t, game.StartGame([]string{"hung"}))
+
+		err := game.SubstitutePlayer(0, "thuy", 4)
+
+		require.NoError(t, err)
+		player := game.GetPlayers()[0]
+		assert.Equal(t, []string{"hung", "hung", "hung", "hung", "thuy", "thuy", "thuy", "thuy", "thuy", "thuy"}, player.GetBowlers())
+	})
+
+	t.Run("should_rename_player_once_substitute_bowls", func(t *testing.T) {
+		game := &TenPinGame{}
+		require.NoError(t, game.StartGame([]string{"hung"}))
+		require.NoError(t, game.SubstitutePlayer(0, "thuy", 2))
+		require.NoError(t, game.SubstitutePlayer(0, "lan", 3))
+
+		player := game.GetPlayers()[0]
+		assert.Equal(t, "hung", player.name, "hung still bowls the current frame")
+		_, err := game.RollBy(0, "hung", 3)
+		require.NoError(t, err)
+		game.NextFrame()
+		assert.Equal(t, "hung", player.name)
+		game.NextFrame()
+		assert.Equal(t, "thuy", player.name)
+		game.NextFrame()
+		assert.Equal(t, "lan", player.name)
+
+		require.NoError(t, game.SubstitutePlayer(0, "mai", 3))
+		assert.Equal(t, "mai", player.name, "the substitute bowls the current frame")
+	})
+
+	t.Run("should_reject_invalid_substitution", func(t *testing.T) {
+		game := &TenPinGame{}
+		require.NoError(t, game.StartGame([]string{"hung", "thuy"}))
+		require.NoError(t, game.WithdrawPlayer(1))
+
+		assert.Error(t, game.SubstitutePlayer(0, "", 1), "empty name")
+		assert.Error(t, game.SubstitutePlayer(0, "lan", 10), "invalid frame index")
+		assert.Error(t, game.SubstitutePlayer(1, "lan", 1), "player has withdrawn")
+	})
+
+	t.Run("should_reject_frames_already_played", func(t *testing.T) {
+		game := &TenPinGame{}
+		require.NoError(t, game.StartGame([]string{"hung"}))
+		require.NoError(t, game.SetFrameResult(0, 3, 4))
+		game.NextFrame()
+		_, err := game.Roll(0, 5)
+		require.NoError(t, err)
+		require.NoError(t, game.AddPlayer("thuy", nil))
+
+		assert.Error(t, game.SubstitutePlayer(0, "lan", 0), "frame bowled by the player")
+		assert.Error(t, game.SubstitutePlayer(0, "lan", 1), "frame in progress")
+		assert.Error(t, game.SubstitutePlayer(1, "lan", 0), "frame left empty for the late player")
+		assert.NoError(t, game.SubstitutePlayer(1, "lan", 1))
+		require.NoError(t, game.SubstitutePlayer(0, "lan", 2))
+
+		assert.Equal(t, []string{"hung", "hung", "lan"}, game.GetPlayers()[0].GetBowlers()[:3])
+		assert.Equal(t, []string{"", "lan"}, game.GetPlayers()[1].GetBowlers()[:2])
+	})
+}
+
+func TestGameManager_Roster(t *testing.T) {
+	t.Run("should_reject_invalid_game_id", func(t *testing.T) {
+		m := NewGameManager()
+
+		_, err := m.AddPlayer(1, "hung", nil)
+		assert.Error(t, err)
+		_, err = m.WithdrawPlayer(1, 0)
+		assert.Error(t, err)
+		_, err = m.SubstitutePlayer(1, 0, "hung", 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("should_return_bowlers_of_players", func(t *testing.T) {
+		m := NewGameManager()
+		startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+		require.NoError(t, err)
+		_, err = m.NextFrame(startGameRes.Id)
+		require.NoError(t, err)
+
+		_, err = m.AddPlayer(startGameRes.Id, "thuy", nil)
+		require.NoError(t, err)
+		_, err = m.SubstitutePlayer(startGameRes.Id, 0, "lan", 1)
+		require.NoError(t, err)
+		res, err := m.WithdrawPlayer(startGameRes.Id, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"hung", "lan"}, res.Players[0].Bowlers[:2])
+		assert.Equal(t, "lan", res.Players[0].Name)
+		assert.Equal(t, []string{"", ""}, res.Players[1].Bowlers[:2])
+		assert.True(t, res.Players[1].Withdrawn)
+
+		res, err = m.Undo(startGameRes.Id)
+		require.NoError(t, err)
+		assert.False(t, res.Players[1].Withdrawn)
+	})
+
+	t.Run("should_score_missed_frames_of_late_player_with_blind_rule", func(t *testing.T) {
+		m := NewGameManager()
+		startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{
+			BlindRule:    BlindRule{Penalty: 10},
+			HandicapRule: HandicapRule{Base: 200, Percentage: 100},
+		})
+		require.NoError(t, err)
+		_, err = m.NextFrame(startGameRes.Id)
+		require.NoError(t, err)
+
+		res, err := m.AddPlayer(startGameRes.Id, "thuy", lo.ToPtr(160))
+
+		require.NoError(t, err)
+		assert.Equal(t, 15, res.Players[1].Scores[0], "a tenth of the average minus the penalty")
+		assert.Equal(t, lo.ToPtr(160), res.Players[1].Average)
+		assert.Equal(t, 40, res.Players[1].Handicap)
+
+		res, err = m.AddPlayer(startGameRes.Id, "lan", nil)
+		require.NoError(t, err)
+		assert.Equal(t, 0, res.Players[2].Scores[0], "the average is unknown")
+	})
+
+	t.Run("should_remove_options_of_late_player_on_undo", func(t *testing.T) {
+		m := NewGameManager()
+		startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{
+			Players: []PlayerOptions{{Average: lo.ToPtr(150)}},
+		})
+		require.NoError(t, err)
+		_, err = m.AddPlayer(startGameRes.Id, "thuy", lo.ToPtr(160))
+		require.NoError(t, err)
+
+		_, err = m.Undo(startGameRes.Id)
+		require.NoError(t, err)
+		playGame(t, m, startGameRes.Id, []int{4, 5})
+		res, err := m.StartNextGame(startGameRes.Id)
+
+		require.NoError(t, err)
+		require.Len(t, res.Players, 1)
+		assert.Equal(t, lo.ToPtr(150), res.Players[0].Average)
+	})
+
+	t.Run("should_keep_options_of_late_player_on_redo", func(t *testing.T) {
+		m := NewGameManager()
+		startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+		require.NoError(t, err)
+		_, err = m.AddPlayer(startGameRes.Id, "thuy", lo.ToPtr(160))
+		require.NoError(t, err)
+		_, err = m.Undo(startGameRes.Id)
+		require.NoError(t, err)
+
+		res, err := m.Redo(startGameRes.Id)
+
+		require.NoError(t, err)
+		assert.Equal(t, lo.ToPtr(160), res.Players[1].Average)
+	})
+}
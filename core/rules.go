@@ -0,0 +1,331 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"slices"
+
+	"bowling-score-tracker/configs"
+)
+
+const maxPinsPerRack = 16
+
+// maxFrames, maxBallsPerFrame and maxPlayersPerLanePair bound the rules of custom games,
+// which are sent by clients and decide the size of the frames of each player
+const maxFrames = 20
+const maxBallsPerFrame = 5
+const maxPlayersPerLanePair = 2 * maxPlayer
+const maxSpareMultiplier = 3
+
+// Rules is the declarative definition of a bowling variant, which decides how a frame is played and scored.
+// The built-in game types are defined with Rules, and custom variants (eg house games) can be defined
+// in a JSON file or in the request to start a game.
+type Rules struct {
+	NumFrames int `json:"num_frames"`
+	// NumPins is the number of pins in a rack
+	NumPins int `json:"num_pins"`
+	// PinValues contains the value of each pin in the rack, eg [2, 3, 5, 3, 2] in 5-pin bowling.
+	// When it is empty, each pin is worth 1 and frame results can be set with the numbers of pins.
+	// Otherwise, frame results must be set with the knocked pins.
+	PinValues []int `json:"pin_values,omitempty"`
+	// BallsPerFrame is the max number of balls rolled in a frame when there is no strike or spare
+	BallsPerFrame int `json:"balls_per_frame"`
+	// StrikeBonusBalls is the number of next balls counted as the bonus of a strike
+	StrikeBonusBalls int `json:"strike_bonus_balls"`
+	// SpareBonusBalls is the number of next balls counted as the bonus of a spare
+	SpareBonusBalls int `json:"spare_bonus_balls"`
+	// StrikeFillBalls is the number of extra balls rolled after a strike in the last frame
+	StrikeFillBalls int `json:"strike_fill_balls"`
+	// SpareFillBalls is the number of extra balls rolled after a spare in the last frame
+	SpareFillBalls int `json:"spare_fill_balls"`
+	// SpareMultiplier multiplies the score of a spare frame including its bonus, eg 2 when spares count double.
+	// 0 means the score is not multiplied.
+	SpareMultiplier int `json:"spare_multiplier,omitempty"`
+	MaxPlayers      int `json:"max_players"`
+	// TapThreshold is the number of pins knocked with the first ball of a rack which counts as a strike,
+	// eg 9 in no-tap bowling. 0 means all pins must be knocked.
+	TapThreshold int `json:"tap_threshold,omitempty"`
+	// ScoringSystem is the scoring system of the game. Empty means the traditional scoring system.
+	ScoringSystem configs.ScoringSystem `json:"scoring_system,omitempty"`
+}
+
+var tenPinRules = Rules{
+	NumFrames:        10,
+	NumPins:          numPin,
+	BallsPerFrame:    2,
+	StrikeBonusBalls: 2,
+	SpareBonusBalls:  1,
+	StrikeFillBalls:  2,
+	SpareFillBalls:   1,
+	MaxPlayers:       maxPlayer,
+}
+
+// Validate checks that the rules describe a playable game
+func (r Rules) Validate() error {
+	if r.NumFrames < 1 || r.NumFrames > maxFrames {
+		return fmt.Errorf("num_frames must be between 1 and %d", maxFrames)
+	}
+	if r.NumPins < 1 || r.NumPins > maxPinsPerRack {
+		return fmt.Errorf("num_pins must be between 1 and %d", maxPinsPerRack)
+	}
+	if len(r.PinValues) > 0 {
+		if len(r.PinValues) != r.NumPins {
+			return errors.New("pin_values must contain the value of each pin")
+		}
+		for _, e := range r.PinValues {
+			if e < 1 {
+				return errors.New("pin_values must be positive")
+			}
+		}
+		if r.TapThreshold != 0 {
+			return errors.New("tap_threshold is not supported with pin_values")
+		}
+	}
+	if r.BallsPerFrame < 1 || r.BallsPerFrame > maxBallsPerFrame {
+		return fmt.Errorf("balls_per_frame must be between 1 and %d", maxBallsPerFrame)
+	}
+	if r.StrikeBonusBalls < 0 || r.SpareBonusBalls < 0 || r.StrikeBonusBalls > maxBallsPerFrame || r.SpareBonusBalls > maxBallsPerFrame {
+		return fmt.Errorf("bonus balls must be between 0 and %d", maxBallsPerFrame)
+	}
+	if r.StrikeFillBalls < 0 || r.SpareFillBalls < 0 || r.StrikeFillBalls > maxBallsPerFrame || r.SpareFillBalls > maxBallsPerFrame {
+		return fmt.Errorf("fill balls must be between 0 and %d", maxBallsPerFrame)
+	}
+	if r.SpareMultiplier < 0 || r.SpareMultiplier > maxSpareMultiplier {
+		return fmt.Errorf("spare_multiplier must be between 0 and %d", maxSpareMultiplier)
+	}
+	if r.MaxPlayers < 1 || r.MaxPlayers > maxPlayersPerLanePair {
+		return fmt.Errorf("max_players must be between 1 and %d", maxPlayersPerLanePair)
+	}
+	if r.TapThreshold < 0 || r.TapThreshold >= r.NumPins {
+		return errors.New("tap_threshold must be less than num_pins")
+	}
+	switch r.ScoringSystem {
+	case "", configs.Traditional, configs.CurrentFrame:
+	default:
+		return errors.New("scoring system is not supported")
+	}
+	return nil
+}
+
+// rackValue returns the value of all pins in a rack
+func (r *Rules) rackValue() int {
+	if len(r.PinValues) == 0 {
+		return r.NumPins
+	}
+
+	res := 0
+	for _, e := range r.PinValues {
+		res += e
+	}
+	return res
+}
+
+// perfectScore returns the max score of a game played with the rules, eg 300 in 10-pin bowling
+func (r *Rules) perfectScore() int {
+	return newPlayer("", r).GetMaxScore()
+}
+
+// spareMultiplier returns the multiplier of the score of a spare frame, which is 1 unless spares count more
+func (r *Rules) spareMultiplier() int {
+	if r.SpareMultiplier == 0 {
+		return 1
+	}
+	return r.SpareMultiplier
+}
+
+// strikePins returns the min number of pins knocked with the first ball of a rack for a strike
+func (r *Rules) strikePins() int {
+	if r.TapThreshold > 0 {
+		return r.TapThreshold
+	}
+	return r.rackValue()
+}
+
+// checkRolls validates the rolls of a frame and returns the number of balls of the frame given the rolls.
+// A strike or spare ends the frame, or earns strikeFill or spareFill extra balls in the last frame.
+// The rack is reset once it is cleared.
+func (r *Rules) checkRolls(pins []int, strikeFill, spareFill int) (int, error) {
+	numBall := r.BallsPerFrame
+	standing := r.rackValue()
+	fresh := true
+	strike := false
+	for i, e := range pins {
+		if i >= numBall {
+			return numBall, fmt.Errorf("invalid input: len must be at most %d", numBall)
+		}
+		if e < 0 || e > standing {
+			return numBall, fmt.Errorf("invalid input for roll %d: %d pins standing", i, standing)
+		}
+
+		if e == standing || (fresh && e >= r.strikePins()) {
+			if i == 0 {
+				strike = true
+				numBall = 1 + strikeFill
+			} else if i == 1 && !strike {
+				numBall = 2 + spareFill
+			}
+			standing = r.rackValue()
+			fresh = true
+		} else {
+			standing -= e
+			fresh = false
+		}
+	}
+	return numBall, nil
+}
+
+// standingValue returns the value of the pins standing for the next roll after the rolls of a frame
+func (r *Rules) standingValue(pins []int) int {
+	standing := r.rackValue()
+	fresh := true
+	for _, e := range pins {
+		if e == standing || (fresh && e >= r.strikePins()) {
+			standing = r.rackValue()
+			fresh = true
+		} else {
+			standing -= e
+			fresh = false
+		}
+	}
+	return standing
+}
+
+// completeRolls appends rolls to the rolls of a frame until the frame is complete.
+// Each roll knocks all standing pins if best, or no pin otherwise.
+func (r *Rules) completeRolls(pins []int, strikeFill, spareFill int, best bool) []int {
+	res := slices.Clone(pins)
+	for {
+		numBall, _ := r.checkRolls(res, strikeFill, spareFill)
+		if len(res) >= numBall {
+			return res
+		}
+		if best {
+			res = append(res, r.standingValue(res))
+		} else {
+			res = append(res, 0)
+		}
+	}
+}
+
+// scoredPins converts the rolls to the values used for scoring.
+// With a tap threshold, a strike counts as all pins even if some pins are left standing.
+func (r *Rules) scoredPins(pins []int) []int {
+	if r.TapThreshold == 0 {
+		return pins
+	}
+
+	var res []int
+	standing := r.rackValue()
+	fresh := true
+	for _, e := range pins {
+		if fresh && e >= r.strikePins() {
+			e = standing
+		}
+		res = append(res, e)
+		standing -= e
+		fresh = standing <= 0
+		if fresh {
+			standing = r.rackValue()
+		}
+	}
+	return res
+}
+
+func (r *Rules) rackMask() PinMask {
+	return 1<<r.NumPins - 1
+}
+
+func (r *Rules) maskValue(mask PinMask) int {
+	if len(r.PinValues) == 0 {
+		return bits.OnesCount32(uint32(mask))
+	}
+
+	res := 0
+	for i, e := range r.PinValues {
+		if mask&(1<<i) != 0 {
+			res += e
+		}
+	}
+	return res
+}
+
+// isRackReset returns whether the rack is reset after a roll leaving standing pins,
+// which is when it is cleared or the roll is a tap strike
+func (r *Rules) isRackReset(standing PinMask, fresh bool, value int) bool {
+	return standing == 0 || (fresh && value >= r.strikePins())
+}
+
+// pinsFromMasks validates the pins knocked by each roll and converts them to their values.
+// The rack is reset once it is cleared.
+func (r *Rules) pinsFromMasks(masks []PinMask) ([]int, error) {
+	var res []int
+	standing := r.rackMask()
+	fresh := true
+	for i, mask := range masks {
+		if mask&^r.rackMask() != 0 {
+			return nil, fmt.Errorf("invalid input: roll %d knocks pins which don't exist", i)
+		}
+		if mask&^standing != 0 {
+			return nil, fmt.Errorf("invalid input: roll %d knocks pins which are already down", i)
+		}
+
+		value := r.maskValue(mask)
+		res = append(res, value)
+		standing &^= mask
+		fresh = r.isRackReset(standing, fresh, value)
+		if fresh {
+			standing = r.rackMask()
+		}
+	}
+	return res, nil
+}
+
+// masksFromLeaves converts the pins left standing after each roll to the pins knocked by each roll.
+// The rack is reset once it is cleared. A foul roll is kept as FoulMask.
+func (r *Rules) masksFromLeaves(leaves []PinMask) ([]PinMask, error) {
+	var res []PinMask
+	standing := r.rackMask()
+	fresh := true
+	for i, leave := range leaves {
+		if leave == FoulMask {
+			// a foul knocks no pin, so the next roll isn't at a fresh rack
+			res = append(res, FoulMask)
+			fresh = false
+			continue
+		}
+		if leave&^standing != 0 {
+			return nil, fmt.Errorf("invalid input: roll %d leaves pins which are already down", i)
+		}
+
+		mask := standing &^ leave
+		res = append(res, mask)
+		standing = leave
+		fresh = r.isRackReset(standing, fresh, r.maskValue(mask))
+		if fresh {
+			standing = r.rackMask()
+		}
+	}
+	return res, nil
+}
+
+// leaves returns the pins left standing after each roll, eg the 7-10 split after the first roll of a frame.
+// A tap strike leaves the pins it didn't knock before the rack is reset.
+func (r *Rules) leaves(masks []PinMask) []PinMask {
+	if masks == nil {
+		return nil
+	}
+
+	var res []PinMask
+	standing := r.rackMask()
+	fresh := true
+	for _, mask := range masks {
+		standing &^= mask
+		res = append(res, standing)
+		fresh = r.isRackReset(standing, fresh, r.maskValue(mask))
+		if fresh {
+			standing = r.rackMask()
+		}
+	}
+	return res
+}
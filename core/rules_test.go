@@ -0,0 +1,81 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRules(t *testing.T) {
+	t.Run("Validate", func(t *testing.T) {
+		t.Run("should_accept_built_in_rules", func(t *testing.T) {
+			for _, rules := range []Rules{tenPinRules, candlepinRules, duckpinRules, fivePinRules} {
+				assert.NoError(t, rules.Validate())
+			}
+		})
+
+		t.Run("should_reject_invalid_rules", func(t *testing.T) {
+			cases := map[string]func(r *Rules){
+				"no_frame":            func(r *Rules) { r.NumFrames = 0 },
+				"too_many_frames":     func(r *Rules) { r.NumFrames = 1 << 50 },
+				"no_pin":              func(r *Rules) { r.NumPins = 0 },
+				"too_many_pins":       func(r *Rules) { r.NumPins = 17 },
+				"missing_pin_values":  func(r *Rules) { r.PinValues = []int{1, 2} },
+				"no_ball":             func(r *Rules) { r.BallsPerFrame = 0 },
+				"too_many_balls":      func(r *Rules) { r.BallsPerFrame = 1e8 },
+				"negative_bonus":      func(r *Rules) { r.SpareBonusBalls = -1 },
+				"too_many_bonus":      func(r *Rules) { r.StrikeBonusBalls = 1e8 },
+				"negative_fill":       func(r *Rules) { r.StrikeFillBalls = -1 },
+				"too_many_fill":       func(r *Rules) { r.SpareFillBalls = 1e8 },
+				"negative_multiplier": func(r *Rules) { r.SpareMultiplier = -1 },
+				"too_high_multiplier": func(r *Rules) { r.SpareMultiplier = 4 },
+				"no_player":           func(r *Rules) { r.MaxPlayers = 0 },
+				"too_many_players":    func(r *Rules) { r.MaxPlayers = 11 },
+				"tap_above_pins":      func(r *Rules) { r.TapThreshold = 10 },
+				"unsupported_scoring": func(r *Rules) { r.ScoringSystem = "abc" },
+			}
+			for name, modify := range cases {
+				t.Run(name, func(t *testing.T) {
+					rules := tenPinRules
+					modify(&rules)
+					assert.Error(t, rules.Validate())
+				})
+			}
+		})
+	})
+
+	t.Run("checkRolls", func(t *testing.T) {
+		t.Run("should_return_num_of_balls_of_frame", func(t *testing.T) {
+			cases := []struct {
+				name     string
+				pins     []int
+				expected int
+			}{
+				{"open", []int{3, 4}, 2},
+				{"strike", []int{10}, 1},
+				{"spare", []int{3, 7}, 2},
+				{"partial", []int{3}, 2},
+			}
+			for _, c := range cases {
+				numBall, err := tenPinRules.checkRolls(c.pins, 0, 0)
+				assert.NoError(t, err, c.name)
+				assert.Equal(t, c.expected, numBall, c.name)
+			}
+		})
+
+		t.Run("should_add_fill_balls_in_last_frame", func(t *testing.T) {
+			numBall, err := tenPinRules.checkRolls([]int{10, 10}, 2, 1)
+			assert.NoError(t, err)
+			assert.Equal(t, 3, numBall)
+
+			numBall, err = tenPinRules.checkRolls([]int{4, 6}, 2, 1)
+			assert.NoError(t, err)
+			assert.Equal(t, 3, numBall)
+		})
+
+		t.Run("should_reject_knocking_more_than_standing_pins", func(t *testing.T) {
+			_, err := tenPinRules.checkRolls([]int{10, 4, 7}, 2, 1)
+			assert.Error(t, err)
+		})
+	})
+}
@@ -0,0 +1,283 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/samber/lo"
+
+	"bowling-score-tracker/configs"
+)
+
+// ScotchDoublesGame implements Scotch doubles, where the 2 partners of a pair alternate deliveries:
+// one throws the first ball and the other the spare attempt, and after a strike the other partner leads off
+// the next frame. The players of the game are the pairs, and each ball is rolled by the partner whose turn it is,
+// who is recorded as its thrower.
+// Frame results and rolls without bowler are not supported.
+type ScotchDoublesGame struct {
+	baseGame
+	// partners contains the partners of each pair, starting with the partner who leads off the game
+	partners [][]string
+	// throwers contains the partner who threw each ball of each frame, by pair
+	throwers [][][]string
+	// replacedPartners contains the turn of each partner replaced by a substitute, by pair,
+	// which is the index of the substitute in partners
+	replacedPartners []map[string]int
+}
+
+func (s *ScotchDoublesGame) GetGameType() configs.GameType {
+	return configs.ScotchDoubles
+}
+
+// StartGame starts a Scotch doubles game between pairs, where the partners of each pair are set in the options of the game
+func (s *ScotchDoublesGame) StartGame(pairNames []string) error {
+	if len(s.partners) != len(pairNames) {
+		return errors.New("each pair requires its partners")
+	}
+	for i, partners := range s.partners {
+		if len(partners) != 2 || partners[0] == "" || partners[1] == "" || partners[0] == partners[1] {
+			return fmt.Errorf("pair at index %d requires 2 partners with different names", i)
+		}
+	}
+	if err := s.startGame(pairNames, tenPinRules); err != nil {
+		return err
+	}
+
+	// the partners change with substitutions, so they are copied from the options of the game
+	s.partners = lo.Map(s.partners, func(item []string, index int) []string {
+		return slices.Clone(item)
+	})
+	s.throwers = lo.Map(s.players, func(item *Player, index int) [][]string {
+		return make([][]string, len(item.frames))
+	})
+	s.replacedPartners = make([]map[string]int, len(s.players))
+	return nil
+}
+
+func (s *ScotchDoublesGame) AddPlayer(name string, blindScore *int) error {
+	return errors.New("pairs can't be added to Scotch doubles games")
+}
+
+// SubstitutePlayer is rejected in Scotch doubles, where a substitute replaces one of the partners with SubstitutePartner
+func (s *ScotchDoublesGame) SubstitutePlayer(playerIndex int, name string, fromFrame int) error {
+	return errors.New("the replaced partner is required in Scotch doubles games")
+}
+
+// SubstitutePartner replaces a partner of a pair from a frame on, which must be the next frame the pair bowls.
+// The substitute throws in the turns of the replaced partner, whose balls already thrown stay credited to them.
+func (s *ScotchDoublesGame) SubstitutePartner(playerIndex int, partner string, name string, fromFrame int) error {
+	if err := s.checkInProgress(); err != nil {
+		return err
+	}
+	player, err := s.getActivePlayer(playerIndex)
+	if err != nil {
+		return err
+	}
+	partners := s.partners[playerIndex]
+	slot := slices.Index(partners, partner)
+	if slot < 0 {
+		return fmt.Errorf("%s is not a partner of the pair", partner)
+	}
+	if name == "" || slices.Contains(partners, name) {
+		return errors.New("substitute must have a name different from the partners")
+	}
+	if fromFrame < 0 || fromFrame >= len(player.frames) {
+		return errors.New("invalid frame index")
+	}
+	first := player.firstUnbowledFrame()
+	if fromFrame < first {
+		return errors.New("frame has already been played")
+	}
+	// the partners take turns ball by ball, so a substitute can't start in a later frame or within a frame
+	inFrame := len(player.frameInProgress().GetPins()) > 0 && !player.frameInProgress().IsComplete()
+	if fromFrame > first || inFrame {
+		return errors.New("a partner can only be substituted from the next frame the pair bowls")
+	}
+
+	if s.replacedPartners[playerIndex] == nil {
+		s.replacedPartners[playerIndex] = map[string]int{}
+	}
+	s.replacedPartners[playerIndex][partner] = slot
+	partners[slot] = name
+	return nil
+}
+
+// RollBy appends a roll thrown by a partner to the current frame of a pair, if it is the turn of the partner
+func (s *ScotchDoublesGame) RollBy(playerIndex int, partner string, pins int) (bool, error) {
+	return s.rollBy(playerIndex, partner, func(frame Frame) error {
+		return frame.Roll(pins)
+	})
+}
+
+// RollPinsBy is the same as RollBy, but with the pins knocked by the roll instead of the number of pins
+func (s *ScotchDoublesGame) RollPinsBy(playerIndex int, partner string, knocked PinMask) (bool, error) {
+	return s.rollBy(playerIndex, partner, func(frame Frame) error {
+		return frame.RollPinMask(knocked)
+	})
+}
+
+// rollBy appends a roll to the current frame of a pair if it is the turn of the partner,
+// and records the partner as its thrower
+func (s *ScotchDoublesGame) rollBy(playerIndex int, partner string, roll func(frame Frame) error) (bool, error) {
+	if err := s.checkInProgress(); err != nil {
+		return false, err
+	}
+	player, err := s.getActivePlayer(playerIndex)
+	if err != nil {
+		return false, err
+	}
+	if next := s.nextThrower(playerIndex); partner != next {
+		return false, fmt.Errorf("it is the turn of %s", next)
+	}
+
+	frame := player.frameInProgress()
+	if err = roll(frame); err != nil {
+		return false, err
+	}
+	throwers := s.throwers[playerIndex]
+	throwers[player.currentFrame] = append(throwers[player.currentFrame], partner)
+	return frame.IsComplete(), nil
+}
+
+// CorrectFrame corrects a frame, and keeps the throwers recorded for its balls.
+// The balls added by the correction are thrown by the partners in turn.
+func (s *ScotchDoublesGame) CorrectFrame(playerIndex int, frameIndex int, pins ...int) error {
+	if err := s.baseGame.CorrectFrame(playerIndex, frameIndex, pins...); err != nil {
+		return err
+	}
+	s.fitThrowers(playerIndex, frameIndex)
+	return nil
+}
+
+// CorrectFramePins is the same as CorrectFrame, but with the pins knocked by each roll instead of the number of pins
+func (s *ScotchDoublesGame) CorrectFramePins(playerIndex int, frameIndex int, knocked ...PinMask) error {
+	if err := s.baseGame.CorrectFramePins(playerIndex, frameIndex, knocked...); err != nil {
+		return err
+	}
+	s.fitThrowers(playerIndex, frameIndex)
+	return nil
+}
+
+// CorrectFrameLeaves is the same as CorrectFramePins, but with the pins left standing after each roll
+func (s *ScotchDoublesGame) CorrectFrameLeaves(playerIndex int, frameIndex int, leaves ...PinMask) error {
+	if err := s.baseGame.CorrectFrameLeaves(playerIndex, frameIndex, leaves...); err != nil {
+		return err
+	}
+	s.fitThrowers(playerIndex, frameIndex)
+	return nil
+}
+
+// fitThrowers matches the throwers of a frame of a pair with its balls after a correction: the throwers of removed balls
+// are dropped, and added balls are thrown by the partners in turn. The throwers of the other frames are kept.
+func (s *ScotchDoublesGame) fitThrowers(playerIndex int, frameIndex int) {
+	balls := len(s.players[playerIndex].frames[frameIndex].GetPins())
+	throwers := s.throwers[playerIndex]
+	if len(throwers[frameIndex]) > balls {
+		throwers[frameIndex] = throwers[frameIndex][:balls]
+		return
+	}
+	for len(throwers[frameIndex]) < balls {
+		next := s.partnerAfter(playerIndex, s.lastThrower(playerIndex, frameIndex))
+		throwers[frameIndex] = append(throwers[frameIndex], next)
+	}
+}
+
+// lastThrower returns the partner of a pair who threw the last recorded ball up to a frame, or empty if there is none
+func (s *ScotchDoublesGame) lastThrower(playerIndex int, frameIndex int) string {
+	throwers := s.throwers[playerIndex]
+	for i := frameIndex; i >= 0; i-- {
+		if n := len(throwers[i]); n > 0 {
+			return throwers[i][n-1]
+		}
+	}
+	return ""
+}
+
+// partnerAfter returns the partner of a pair who throws after a partner, who is the partner of the other turn,
+// or the partner who leads off the game if nobody has thrown yet. A replaced partner keeps their turn for the substitute.
+func (s *ScotchDoublesGame) partnerAfter(playerIndex int, thrower string) string {
+	partners := s.partners[playerIndex]
+	slot := slices.Index(partners, thrower)
+	if former, ok := s.replacedPartners[playerIndex][thrower]; ok && slot < 0 {
+		slot = former
+	}
+	if slot < 0 {
+		return partners[0]
+	}
+	return partners[1-slot]
+}
+
+// nextThrower returns the partner of a pair whose turn it is to throw the next ball
+func (s *ScotchDoublesGame) nextThrower(playerIndex int) string {
+	return s.partnerAfter(playerIndex, s.lastThrower(playerIndex, len(s.throwers[playerIndex])-1))
+}
+
+// GetThrowers returns the partner who threw each ball of each frame of a pair
+func (s *ScotchDoublesGame) GetThrowers(playerIndex int) [][]string {
+	return lo.Map(s.throwers[playerIndex], func(item []string, index int) []string {
+		return slices.Clone(item)
+	})
+}
+
+// PartnerStats contains the statistics of a partner over the balls they threw in Scotch doubles
+type PartnerStats struct {
+	Name string `json:"name"`
+	// Balls is the number of balls thrown
+	Balls int `json:"balls"`
+	// Strikes counts the first balls of a rack knocking all pins
+	Strikes int `json:"strikes"`
+	// SpareAttempts counts the balls thrown at the pins left by the other partner, and Spares the attempts clearing them
+	SpareAttempts int `json:"spare_attempts"`
+	Spares        int `json:"spares"`
+	// Pins is the number of pins knocked
+	Pins  int `json:"pins"`
+	Fouls int `json:"fouls"`
+}
+
+// GetPartnerStats returns the statistics of each partner of a pair.
+// The replaced partners are listed after the current partners.
+func (s *ScotchDoublesGame) GetPartnerStats(playerIndex int) []PartnerStats {
+	player := s.players[playerIndex]
+	throwers := s.throwers[playerIndex]
+	res := lo.Map(s.partners[playerIndex], func(item string, index int) PartnerStats {
+		return PartnerStats{Name: item}
+	})
+	for f, frame := range player.frames {
+		fouls := frame.GetFouls()
+		standing := s.rules.rackValue()
+		fresh := true
+		for i, pins := range frame.GetScoredPins() {
+			j := slices.IndexFunc(res, func(e PartnerStats) bool {
+				return e.Name == throwers[f][i]
+			})
+			if j < 0 {
+				res = append(res, PartnerStats{Name: throwers[f][i]})
+				j = len(res) - 1
+			}
+			stats := &res[j]
+			stats.Balls++
+			stats.Pins += pins
+			if slices.Contains(fouls, i) {
+				stats.Fouls++
+			}
+
+			switch {
+			case fresh && pins >= s.rules.strikePins():
+				stats.Strikes++
+			case fresh:
+				standing -= pins
+				fresh = false
+				continue
+			default:
+				stats.SpareAttempts++
+				if pins == standing {
+					stats.Spares++
+				}
+			}
+			standing = s.rules.rackValue()
+			fresh = true
+		}
+	}
+	return res
+}
@@ -0,0 +1,261 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"bowling-score-tracker/configs"
+)
+
+func TestScotchDoublesGame(t *testing.T) {
+	t.Run("StartGame", func(t *testing.T) {
+		t.Run("should_reject_invalid_partners", func(t *testing.T) {
+			assert.Error(t, (&ScotchDoublesGame{}).StartGame([]string{"A"}), "missing partners")
+			assert.Error(t, (&ScotchDoublesGame{partners: [][]string{{"a1"}}}).StartGame([]string{"A"}), "1 partner")
+			assert.Error(t, (&ScotchDoublesGame{partners: [][]string{{"a1", "a1"}}}).StartGame([]string{"A"}), "same partner")
+			assert.Error(t, (&ScotchDoublesGame{partners: [][]string{{"a1", ""}}}).StartGame([]string{"A"}), "empty name")
+		})
+	})
+
+	t.Run("RollBy", func(t *testing.T) {
+		t.Run("should_alternate_partners", func(t *testing.T) {
+			game := &ScotchDoublesGame{partners: [][]string{{"hung", "thuy"}}}
+			require.NoError(t, game.StartGame([]string{"A"}))
+
+			_, err := game.RollBy(0, "thuy", 7)
+			assert.Error(t, err, "hung throws the first ball")
+
+			_, err = game.RollBy(0, "hung", 7)
+			require.NoError(t, err)
+			complete, err := game.RollBy(0, "thuy", 3)
+			require.NoError(t, err)
+			assert.True(t, complete)
+			game.NextFrame()
+			// hung leads off the frame after a spare
+			_, err = game.RollBy(0, "hung", 10)
+			require.NoError(t, err)
+			game.NextFrame()
+			// thuy leads off the frame after a strike
+			_, err = game.RollBy(0, "hung", 4)
+			assert.Error(t, err)
+			_, err = game.RollBy(0, "thuy", 4)
+			require.NoError(t, err)
+
+			assert.Equal(t, []string{"hung", "thuy"}, game.GetThrowers(0)[0])
+			assert.Equal(t, []string{"hung"}, game.GetThrowers(0)[1])
+			assert.Equal(t, []string{"thuy"}, game.GetThrowers(0)[2])
+		})
+	})
+
+	t.Run("RollPinsBy", func(t *testing.T) {
+		t.Run("should_record_thrower_of_knocked_pins", func(t *testing.T) {
+			game := &ScotchDoublesGame{partners: [][]string{{"hung", "thuy"}}}
+			require.NoError(t, game.StartGame([]string{"A"}))
+
+			_, err := game.RollPinsBy(0, "thuy", pins(1, 2, 3))
+			assert.Error(t, err, "hung throws the first ball")
+			_, err = game.RollPinsBy(0, "hung", pins(1, 2, 3))
+			require.NoError(t, err)
+			complete, err := game.RollPinsBy(0, "thuy", pins(4, 5, 6, 7, 8, 9, 10))
+			require.NoError(t, err)
+
+			assert.True(t, complete)
+			assert.Equal(t, []string{"hung", "thuy"}, game.GetThrowers(0)[0])
+		})
+	})
+
+	t.Run("should_require_bowler_of_each_ball", func(t *testing.T) {
+		var game Game = &ScotchDoublesGame{}
+
+		_, ok := game.(roller)
+		assert.False(t, ok)
+		_, ok = game.(frameSetter)
+		assert.False(t, ok)
+	})
+
+	t.Run("SubstitutePartner", func(t *testing.T) {
+		t.Run("should_throw_in_turns_of_replaced_partner", func(t *testing.T) {
+			game := &ScotchDoublesGame{partners: [][]string{{"hung", "thuy"}}}
+			require.NoError(t, game.StartGame([]string{"A"}))
+			rollFrames(t, game, []string{"hung"}, []int{10})
+
+			require.NoError(t, game.SubstitutePartner(0, "thuy", "lan", 1))
+
+			_, err := game.RollBy(0, "hung", 7)
+			assert.Error(t, err, "thuy leads off the frame after a strike")
+			_, err = game.RollBy(0, "lan", 7)
+			require.NoError(t, err)
+			_, err = game.RollBy(0, "hung", 2)
+			require.NoError(t, err)
+			assert.Equal(t, [][]string{{"hung"}, {"lan", "hung"}}, game.GetThrowers(0)[:2])
+		})
+
+		t.Run("should_reject_invalid_substitution", func(t *testing.T) {
+			game := &ScotchDoublesGame{partners: [][]string{{"hung", "thuy"}}}
+			require.NoError(t, game.StartGame([]string{"A"}))
+			_, err := game.RollBy(0, "hung", 7)
+			require.NoError(t, err)
+
+			assert.Error(t, game.SubstitutePlayer(0, "lan", 1), "the replaced partner is required")
+			assert.Error(t, game.SubstitutePartner(0, "lan", "mai", 1), "lan is not a partner")
+			assert.Error(t, game.SubstitutePartner(0, "hung", "thuy", 1), "thuy is already a partner")
+			assert.Error(t, game.SubstitutePartner(0, "hung", "lan", 0), "frame 0 has already been played")
+			assert.Error(t, game.SubstitutePartner(0, "hung", "lan", 1), "frame 0 is still in progress")
+			_, err = game.RollBy(0, "thuy", 1)
+			require.NoError(t, err)
+			assert.Error(t, game.SubstitutePartner(0, "hung", "lan", 2), "frame 1 is bowled before")
+			assert.NoError(t, game.SubstitutePartner(0, "hung", "lan", 1))
+		})
+	})
+
+	t.Run("CorrectFrame", func(t *testing.T) {
+		t.Run("should_keep_throwers_of_other_frames", func(t *testing.T) {
+			game := &ScotchDoublesGame{partners: [][]string{{"hung", "thuy"}}}
+			require.NoError(t, game.StartGame([]string{"A"}))
+			rollFrames(t, game, []string{"hung"}, []int{10})
+			rollFrames(t, game, []string{"thuy", "hung"}, []int{7, 3})
+			_, err := game.RollBy(0, "thuy", 8)
+			require.NoError(t, err)
+
+			// the strike was a 9 spare, thrown by hung then thuy
+			require.NoError(t, game.CorrectFrame(0, 0, 9, 1))
+
+			assert.Equal(t, [][]string{{"hung", "thuy"}, {"thuy", "hung"}, {"thuy"}}, game.GetThrowers(0)[:3])
+			_, err = game.RollBy(0, "thuy", 1)
+			assert.Error(t, err, "the correction doesn't change whose turn it is")
+			_, err = game.RollBy(0, "hung", 1)
+			assert.NoError(t, err)
+
+			require.NoError(t, game.CorrectFrame(0, 1, 10))
+			assert.Equal(t, [][]string{{"hung", "thuy"}, {"thuy"}, {"thuy", "hung"}}, game.GetThrowers(0)[:3])
+		})
+	})
+
+	t.Run("GetPartnerStats", func(t *testing.T) {
+		t.Run("should_keep_separate_stats_of_partners", func(t *testing.T) {
+			game := &ScotchDoublesGame{partners: [][]string{{"hung", "thuy"}}}
+			require.NoError(t, game.StartGame([]string{"A"}))
+			rollFrames(t, game, []string{"hung", "thuy"}, []int{7, 3})
+			rollFrames(t, game, []string{"hung"}, []int{10})
+			rollFrames(t, game, []string{"thuy", "hung"}, []int{6, Foul})
+
+			stats := game.GetPartnerStats(0)
+
+			assert.Equal(t, []PartnerStats{
+				{Name: "hung", Balls: 3, Strikes: 1, SpareAttempts: 1, Pins: 17, Fouls: 1},
+				{Name: "thuy", Balls: 2, SpareAttempts: 1, Spares: 1, Pins: 9},
+			}, stats)
+		})
+
+		t.Run("should_keep_stats_of_replaced_partner", func(t *testing.T) {
+			game := &ScotchDoublesGame{partners: [][]string{{"hung", "thuy"}}}
+			require.NoError(t, game.StartGame([]string{"A"}))
+			rollFrames(t, game, []string{"hung", "thuy"}, []int{7, 3})
+			require.NoError(t, game.SubstitutePartner(0, "hung", "lan", 1))
+			rollFrames(t, game, []string{"lan"}, []int{10})
+
+			stats := game.GetPartnerStats(0)
+
+			assert.Equal(t, []PartnerStats{
+				{Name: "lan", Balls: 1, Strikes: 1, Pins: 10},
+				{Name: "thuy", Balls: 1, SpareAttempts: 1, Spares: 1, Pins: 3},
+				{Name: "hung", Balls: 1, Pins: 7},
+			}, stats)
+		})
+
+		t.Run("should_not_be_tracked_in_other_games", func(t *testing.T) {
+			var game Game = &TenPinGame{}
+
+			_, ok := game.(partnerTracker)
+			assert.False(t, ok)
+		})
+	})
+}
+
+func TestTenPinGame_RollBy(t *testing.T) {
+	t.Run("should_check_bowler_of_frame", func(t *testing.T) {
+		game := &TenPinGame{}
+		require.NoError(t, game.StartGame([]string{"hung"}))
+		require.NoError(t, game.SubstitutePlayer(0, "thuy", 0))
+
+		_, err := game.RollBy(0, "hung", 3)
+		assert.Error(t, err)
+		_, err = game.RollBy(0, "thuy", 3)
+		assert.NoError(t, err)
+	})
+}
+
+func TestGameManager_ScotchDoubles(t *testing.T) {
+	t.Run("should_return_throwers_and_partner_stats", func(t *testing.T) {
+		m := NewGameManager()
+		startGameRes, err := m.StartGame(configs.ScotchDoubles, []string{"A"}, GameOptions{Partners: [][]string{{"hung", "thuy"}}})
+		require.NoError(t, err)
+
+		res, complete, err := m.RollBy(startGameRes.Id, 0, "hung", 10)
+
+		require.NoError(t, err)
+		assert.True(t, complete)
+		assert.Equal(t, configs.ScotchDoubles, res.GameType)
+		assert.Equal(t, []string{"hung"}, res.Players[0].Throwers[0])
+		assert.Equal(t, 1, res.Players[0].PartnerStats[0].Strikes)
+
+		_, _, err = m.RollBy(startGameRes.Id, 0, "hung", 10)
+		assert.Error(t, err, "it is the turn of thuy")
+		_, _, err = m.Roll(startGameRes.Id, 0, 10)
+		assert.Error(t, err, "each ball requires its bowler")
+		_, err = m.SetFrameResult(startGameRes.Id, 0, 10)
+		assert.Error(t, err, "each ball requires its bowler")
+
+		res, err = m.Undo(startGameRes.Id)
+		require.NoError(t, err)
+		assert.Nil(t, res.Players[0].Throwers[0])
+		_, _, err = m.RollBy(startGameRes.Id, 0, "hung", 10)
+		assert.NoError(t, err, "hung leads off again after the undo")
+	})
+
+	t.Run("should_substitute_partner", func(t *testing.T) {
+		m := NewGameManager()
+		startGameRes, err := m.StartGame(configs.ScotchDoubles, []string{"A"}, GameOptions{Partners: [][]string{{"hung", "thuy"}}})
+		require.NoError(t, err)
+
+		_, err = m.SubstitutePlayer(startGameRes.Id, 0, "zz", 0)
+		assert.Error(t, err, "the replaced partner is required")
+		_, err = m.SubstitutePartner(startGameRes.Id, 0, "hung", "zz", 0)
+		require.NoError(t, err)
+		res, _, err := m.RollBy(startGameRes.Id, 0, "zz", 7)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"zz", "thuy"}, lo.Map(res.Players[0].PartnerStats, func(item PartnerStats, index int) string {
+			return item.Name
+		}))
+		_, err = m.SubstitutePartner(startGameRes.Id, 0, "zz", "lan", 0)
+		assert.Error(t, err, "frame has already been played")
+	})
+
+	t.Run("should_restore_partner_when_substitution_is_undone", func(t *testing.T) {
+		m := NewGameManager()
+		startGameRes, err := m.StartGame(configs.ScotchDoubles, []string{"A"}, GameOptions{Partners: [][]string{{"hung", "thuy"}}})
+		require.NoError(t, err)
+		_, err = m.SubstitutePartner(startGameRes.Id, 0, "hung", "zz", 0)
+		require.NoError(t, err)
+
+		_, err = m.Undo(startGameRes.Id)
+		require.NoError(t, err)
+
+		_, _, err = m.RollBy(startGameRes.Id, 0, "hung", 7)
+		assert.NoError(t, err, "the options of the game keep the original partners")
+	})
+}
+
+// rollFrames rolls a frame of the first pair of a Scotch doubles game with the partner who threw each ball,
+// and moves to the next frame
+func rollFrames(t *testing.T, game *ScotchDoublesGame, throwers []string, rolls []int) {
+	for i, pins := range rolls {
+		_, err := game.RollBy(0, throwers[i], pins)
+		require.NoError(t, err)
+	}
+	game.NextFrame()
+}
@@ -0,0 +1,125 @@
+package core
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/samber/lo"
+
+	"bowling-score-tracker/configs"
+)
+
+// series groups the games bowled in a row by the same players, eg the 3 games of a league night
+type series struct {
+	// id is the id of the first game of the series
+	id      int32
+	gameIds []int32
+}
+
+// SeriesInfo contains the totals of the players over the games of a series
+type SeriesInfo struct {
+	Id      int32          `json:"id"`
+	GameIds []int32        `json:"game_ids"`
+	Players []PlayerSeries `json:"players"`
+	// Teams contains the totals of the teams of a series of team games
+	Teams []TeamSeries `json:"teams,omitempty"`
+}
+
+// PlayerSeries contains the totals of a player over the games of a series
+type PlayerSeries struct {
+	// Name is the name of the current bowler of the player
+	Name string `json:"name"`
+	// GameScores contains the scratch total score of each game
+	GameScores    []int `json:"game_scores"`
+	ScratchTotal  int   `json:"scratch_total"`
+	HandicapTotal int   `json:"handicap_total"`
+	// HighGame is the highest scratch score of the games bowled by the player, ie without blind games
+	HighGame int  `json:"high_game"`
+	Average  *int `json:"average,omitempty"`
+	// PinsOverAverage is the sum of the scratch scores minus the average of the games bowled by the player.
+	// It is null when the average of the player is unknown.
+	PinsOverAverage *int `json:"pins_over_average"`
+}
+
+// StartNextGame starts the next game of the series of a finished game, with the same game type, options and players.
+// The series is created with the finished game if the game isn't in a series yet.
+func (m *GameManager) StartNextGame(gameId int32) (g GameInfo, err error) {
+	game := m.GameById[gameId]
+	if game == nil {
+		return g, errors.New("invalid game id")
+	}
+	if game.GetStatus() == configs.InProgress {
+		return g, errors.New("game is in progress")
+	}
+	s := m.seriesByGameId[gameId]
+	if s == nil {
+		s = &series{id: gameId, gameIds: []int32{gameId}}
+	}
+	if s.gameIds[len(s.gameIds)-1] != gameId {
+		return g, errors.New("game is not the last game of its series")
+	}
+
+	names := lo.Map(game.GetPlayers(), func(item *Player, index int) string {
+		return item.name
+	})
+	g, err = m.StartGame(game.GetGameType(), names, m.options(gameId))
+	if err != nil {
+		return g, err
+	}
+
+	s.gameIds = append(s.gameIds, g.Id)
+	for _, e := range s.gameIds {
+		m.seriesByGameId[e] = s
+	}
+	return m.toGameInfo(g.Id, m.GameById[g.Id]), nil
+}
+
+// GetSeries returns the totals of the series of a game
+func (m *GameManager) GetSeries(gameId int32) (res SeriesInfo, err error) {
+	if m.GameById[gameId] == nil {
+		return res, errors.New("invalid game id")
+	}
+	s := m.seriesByGameId[gameId]
+	if s == nil {
+		return res, errors.New("game is not in a series")
+	}
+
+	return m.toSeriesInfo(s), nil
+}
+
+func (m *GameManager) toSeriesInfo(s *series) SeriesInfo {
+	res := SeriesInfo{
+		Id:      s.id,
+		GameIds: slices.Clone(s.gameIds),
+	}
+	// players are matched by index, because the games of a series are started with the same players
+	for _, gameId := range s.gameIds {
+		players := m.toPlayerScores(gameId, m.GameById[gameId])
+		for i, team := range getTeamScores(m.options(gameId).Teams, players) {
+			if i == len(res.Teams) {
+				res.Teams = append(res.Teams, TeamSeries{Name: team.Name})
+			}
+			res.Teams[i].GameScores = append(res.Teams[i].GameScores, team.ScratchTotal)
+			res.Teams[i].ScratchTotal += team.ScratchTotal
+			res.Teams[i].HandicapTotal += team.HandicapTotal
+		}
+		for i, p := range players {
+			if i == len(res.Players) {
+				res.Players = append(res.Players, PlayerSeries{Average: p.Average})
+			}
+			e := &res.Players[i]
+			e.Name = p.Name
+			e.GameScores = append(e.GameScores, p.TotalScore)
+			e.ScratchTotal += p.TotalScore
+			e.HandicapTotal += p.HandicapTotal
+			if p.Blind {
+				continue
+			}
+			e.HighGame = max(e.HighGame, p.TotalScore)
+			if e.Average != nil {
+				e.PinsOverAverage = lo.ToPtr(lo.FromPtr(e.PinsOverAverage) + p.TotalScore - *e.Average)
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,90 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"bowling-score-tracker/configs"
+)
+
+func TestGameManager_Series(t *testing.T) {
+	t.Run("should_reject_invalid_game", func(t *testing.T) {
+		m := NewGameManager()
+		startGameRes, err := m.StartGame(configs.TenPin, []string{"hung"}, GameOptions{})
+		require.NoError(t, err)
+
+		_, err = m.StartNextGame(0)
+		assert.Error(t, err, "invalid game id")
+		_, err = m.StartNextGame(startGameRes.Id)
+		assert.Error(t, err, "game is in progress")
+		_, err = m.GetSeries(startGameRes.Id)
+		assert.Error(t, err, "game is not in a series")
+	})
+
+	t.Run("should_start_next_game_with_same_players_and_options", func(t *testing.T) {
+		m := NewGameManager()
+		opts := GameOptions{
+			ScoringSystem: configs.CurrentFrame,
+			Players:       []PlayerOptions{{Average: lo.ToPtr(150)}},
+		}
+		startGameRes, err := m.StartGame(configs.Candlepin, []string{"hung", "thuy"}, opts)
+		require.NoError(t, err)
+		_, err = m.AbandonGame(startGameRes.Id)
+		require.NoError(t, err)
+
+		res, err := m.StartNextGame(startGameRes.Id)
+
+		require.NoError(t, err)
+		assert.Equal(t, configs.Candlepin, res.GameType)
+		assert.Equal(t, configs.CurrentFrame, res.ScoringSystem)
+		assert.Equal(t, startGameRes.Id, res.SeriesId)
+		assert.Equal(t, []string{"hung", "thuy"}, []string{res.Players[0].Name, res.Players[1].Name})
+		assert.Equal(t, lo.ToPtr(150), res.Players[0].Average)
+
+		_, err = m.StartNextGame(startGameRes.Id)
+		assert.Error(t, err, "game is not the last game of its series")
+	})
+
+	t.Run("should_return_series_totals", func(t *testing.T) {
+		m := NewGameManager()
+		startGameRes, err := m.StartGame(configs.TenPin, []string{"hung", "thuy"}, GameOptions{
+			HandicapRule: HandicapRule{Fixed: 10},
+			Players:      []PlayerOptions{{Average: lo.ToPtr(100)}},
+		})
+		require.NoError(t, err)
+		playGame(t, m, startGameRes.Id, []int{4, 5}, []int{3, 4})
+		nextGameRes, err := m.StartNextGame(startGameRes.Id)
+		require.NoError(t, err)
+		playGame(t, m, nextGameRes.Id, []int{5, 5}, []int{4, 4})
+
+		res, err := m.GetSeries(nextGameRes.Id)
+
+		require.NoError(t, err)
+		assert.Equal(t, SeriesInfo{
+			Id:      startGameRes.Id,
+			GameIds: []int32{startGameRes.Id, nextGameRes.Id},
+			Players: []PlayerSeries{
+				{
+					Name: "hung",
+					// the spares are followed by 5 pins, and the last spare by a fill ball of 0
+					GameScores:      []int{90, 145},
+					ScratchTotal:    235,
+					HandicapTotal:   255,
+					HighGame:        145,
+					Average:         lo.ToPtr(100),
+					PinsOverAverage: lo.ToPtr(35),
+				},
+				{
+					Name:          "thuy",
+					GameScores:    []int{70, 80},
+					ScratchTotal:  150,
+					HandicapTotal: 170,
+					HighGame:      80,
+				},
+			},
+		}, res)
+	})
+}
@@ -0,0 +1,123 @@
+package core
+
+import "slices"
+
+// Split is a leave of the first ball of a rack where the standing pins are apart, as circled on paper scoresheets.
+type Split struct {
+	// Roll is the index of the roll leaving the split in the frame
+	Roll int `json:"roll"`
+	// Leave contains the pins left standing by the roll
+	Leave PinMask `json:"leave"`
+	// Washout is a split-like leave with the headpin standing, eg 1-2-10. It is not counted as a split.
+	Washout bool `json:"washout"`
+	// Converted is whether the next roll knocked all pins of the leave
+	Converted bool `json:"converted"`
+}
+
+// pinPosition is the position of a pin in the rack, where the row is counted from the headpin
+// and the column is counted in half pin spacings from the center line
+type pinPosition struct {
+	row, column int
+}
+
+// triangleLayout contains the positions of pins in a 10-pin triangle rack, which is also used by candlepin and duckpin
+var triangleLayout = []pinPosition{
+	{0, 0},
+	{1, -1}, {1, 1},
+	{2, -2}, {2, 0}, {2, 2},
+	{3, -3}, {3, -1}, {3, 1}, {3, 3},
+}
+
+// isAdjacent returns whether there is no pin between 2 pins: next to each other in a row, diagonally in consecutive rows,
+// or directly behind in the next but one row (a sleeper, eg 2-8)
+func (p pinPosition) isAdjacent(o pinPosition) bool {
+	dRow, dColumn := abs(p.row-o.row), abs(p.column-o.column)
+	return (dRow == 0 && dColumn == 2) || (dRow == 1 && dColumn == 1) || (dRow == 2 && dColumn == 0)
+}
+
+// isConnected returns whether 2 standing pins of a leave are adjacent, and pins next to each other in a row
+// also have the pin immediately ahead of them standing, eg 5-6 are apart when pin 3 is down
+func isConnected(leave PinMask, i, j int) bool {
+	p, o := triangleLayout[i], triangleLayout[j]
+	if !p.isAdjacent(o) {
+		return false
+	}
+	if p.row != o.row {
+		return true
+	}
+
+	ahead := slices.Index(triangleLayout, pinPosition{p.row - 1, (p.column + o.column) / 2})
+	return ahead >= 0 && leave&(1<<ahead) != 0
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// isApart returns whether the standing pins of a leave are not connected by adjacent pins.
+// USBC defines a split as a leave where the headpin is down, and a pin is down between standing pins (eg 7-10)
+// or immediately ahead of standing pins (eg 5-6 with pin 3 down).
+func isApart(leave PinMask) bool {
+	var standing []int
+	for i := range triangleLayout {
+		if leave&(1<<i) != 0 {
+			standing = append(standing, i)
+		}
+	}
+	if len(standing) < 2 {
+		return false
+	}
+
+	// flood fill the standing pins from the first one
+	connected := PinMask(1) << standing[0]
+	for changed := true; changed; {
+		changed = false
+		for _, i := range standing {
+			if connected&(1<<i) != 0 {
+				continue
+			}
+			for _, j := range standing {
+				if connected&(1<<j) != 0 && isConnected(leave, i, j) {
+					connected |= 1 << i
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	return connected != leave
+}
+
+// splits returns the splits and washouts left by the first ball of each rack in a frame.
+// Splits are only detected in triangle racks of 10 pins.
+func (r *Rules) splits(masks []PinMask) []Split {
+	if r.NumPins != len(triangleLayout) {
+		return nil
+	}
+
+	var res []Split
+	leaves := r.leaves(masks)
+	standing := r.rackMask()
+	fresh := true
+	for i, mask := range masks {
+		standing &^= mask
+		reset := r.isRackReset(standing, fresh, r.maskValue(mask))
+		if fresh && !reset && isApart(leaves[i]) {
+			res = append(res, Split{
+				Roll:  i,
+				Leave: leaves[i],
+				// the headpin is pin 1
+				Washout:   leaves[i]&1 != 0,
+				Converted: i+1 < len(leaves) && leaves[i+1] == 0,
+			})
+		}
+		fresh = reset
+		if fresh {
+			standing = r.rackMask()
+		}
+	}
+	return res
+}
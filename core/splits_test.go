@@ -0,0 +1,81 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pins converts pin numbers to a pin mask
+func pins(numbers ...int) PinMask {
+	var res PinMask
+	for _, e := range numbers {
+		res |= 1 << (e - 1)
+	}
+	return res
+}
+
+func TestSplits(t *testing.T) {
+	t.Run("isApart", func(t *testing.T) {
+		t.Run("should_detect_pins_apart", func(t *testing.T) {
+			for _, leave := range []PinMask{pins(7, 10), pins(4, 6), pins(2, 7), pins(3, 10), pins(5, 7), pins(4, 7, 10), pins(6, 7), pins(1, 2, 10)} {
+				assert.True(t, isApart(leave), "%b", leave)
+			}
+		})
+
+		t.Run("should_detect_pins_with_pin_down_ahead", func(t *testing.T) {
+			for _, leave := range []PinMask{pins(5, 6), pins(4, 5), pins(7, 8), pins(8, 9), pins(9, 10), pins(2, 3)} {
+				assert.True(t, isApart(leave), "%b", leave)
+			}
+		})
+
+		t.Run("should_not_detect_pins_next_to_each_other", func(t *testing.T) {
+			for _, leave := range []PinMask{0, pins(7), pins(4, 7, 8), pins(3, 5, 6), pins(2, 4), pins(2, 8), pins(3, 9), pins(2, 4, 5, 8), pins(1, 2, 4, 7), pins(1, 2, 3)} {
+				assert.False(t, isApart(leave), "%b", leave)
+			}
+		})
+	})
+
+	t.Run("splits", func(t *testing.T) {
+		rack := tenPinRules.rackMask()
+
+		t.Run("should_mark_converted_split", func(t *testing.T) {
+			res := tenPinRules.splits([]PinMask{rack &^ pins(7, 10), pins(7, 10)})
+
+			assert.Equal(t, []Split{{Roll: 0, Leave: pins(7, 10), Converted: true}}, res)
+		})
+
+		t.Run("should_mark_missed_washout", func(t *testing.T) {
+			res := tenPinRules.splits([]PinMask{rack &^ pins(1, 2, 10), pins(1, 2)})
+
+			assert.Equal(t, []Split{{Roll: 0, Leave: pins(1, 2, 10), Washout: true}}, res)
+		})
+
+		t.Run("should_ignore_leave_of_second_ball", func(t *testing.T) {
+			res := tenPinRules.splits([]PinMask{rack &^ pins(4, 7, 10), pins(4)})
+
+			assert.Equal(t, []Split{{Roll: 0, Leave: pins(4, 7, 10)}}, res, "only the first ball of a rack can leave a split")
+		})
+
+		t.Run("should_detect_split_after_strike_in_last_frame", func(t *testing.T) {
+			res := tenPinRules.splits([]PinMask{rack, rack &^ pins(4, 6), pins(4, 6)})
+
+			assert.Equal(t, []Split{{Roll: 1, Leave: pins(4, 6), Converted: true}}, res)
+		})
+
+		t.Run("should_ignore_leave_of_tap_strike", func(t *testing.T) {
+			rules := tenPinRules
+			rules.TapThreshold = 8
+
+			res := rules.splits([]PinMask{rack &^ pins(7, 10)})
+
+			assert.Empty(t, res)
+		})
+
+		t.Run("should_ignore_racks_other_than_10_pins", func(t *testing.T) {
+			res := fivePinRules.splits([]PinMask{headPin, 0, 0})
+
+			assert.Nil(t, res)
+		})
+	})
+}
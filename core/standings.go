@@ -0,0 +1,56 @@
+package core
+
+import (
+	"slices"
+)
+
+// Standing is the final place of a player in a game
+type Standing struct {
+	// Place starts from 1. Players with the same total score share the same place, and the next place is skipped.
+	Place       int    `json:"place"`
+	PlayerIndex int    `json:"player_index"`
+	Name        string `json:"name"`
+	TotalScore  int    `json:"total_score"`
+	// Winner is whether the player is at the first place. There are several winners in case of a tie.
+	Winner bool `json:"winner"`
+	// Withdrawn is whether the player stopped before the end of the game, who is ranked below the players who finished
+	Withdrawn bool `json:"withdrawn,omitempty"`
+}
+
+// getStandings ranks the players by total score, from the highest.
+// Withdrawn players are ranked below the players who finished, by their partial totals.
+// Blind players are not ranked, because their blind scores only count in team totals.
+// Late players are ranked by the pins they bowled, without the blind scores of the frames they missed.
+func getStandings(players []*Player) []Standing {
+	var res []Standing
+	for i, e := range players {
+		if e.IsBlind() {
+			continue
+		}
+		res = append(res, Standing{
+			PlayerIndex: i,
+			Name:        e.name,
+			TotalScore:  e.getBowledScore(),
+			Withdrawn:   e.IsWithdrawn(),
+		})
+	}
+	slices.SortStableFunc(res, func(a, b Standing) int {
+		if a.Withdrawn && !b.Withdrawn {
+			return 1
+		}
+		if !a.Withdrawn && b.Withdrawn {
+			return -1
+		}
+		return b.TotalScore - a.TotalScore
+	})
+
+	for i := range res {
+		if i > 0 && res[i].TotalScore == res[i-1].TotalScore && res[i].Withdrawn == res[i-1].Withdrawn {
+			res[i].Place = res[i-1].Place
+		} else {
+			res[i].Place = i + 1
+		}
+		res[i].Winner = res[i].Place == 1
+	}
+	return res
+}
@@ -0,0 +1,73 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+)
+
+// Team is a team of players in a game. The players of a team are consecutive in the players of the game,
+// eg players 0-4 are the first team and players 5-9 are the second team on a lane pair.
+type Team struct {
+	Name       string `json:"name"`
+	NumPlayers int    `json:"num_players"`
+}
+
+// validateTeams checks that the teams of a game split its players, and that each team has at most maxPlayers players
+func validateTeams(teams []Team, numPlayers int, maxPlayers int) error {
+	if len(teams) == 0 {
+		return nil
+	}
+	if len(teams) < 2 {
+		return errors.New("a team game has at least 2 teams")
+	}
+	for i, e := range teams {
+		if e.Name == "" {
+			return fmt.Errorf("team at index %d has empty name", i)
+		}
+		if e.NumPlayers < 1 || e.NumPlayers > maxPlayers {
+			return fmt.Errorf("num of players of team %s must be between 1 and %d", e.Name, maxPlayers)
+		}
+	}
+	if lo.SumBy(teams, func(item Team) int { return item.NumPlayers }) != numPlayers {
+		return errors.New("players of teams don't match the players of the game")
+	}
+	return nil
+}
+
+// TeamScore contains the totals of a team in a game
+type TeamScore struct {
+	Name string `json:"name"`
+	// PlayerIndexes contains the indexes of the players of the team
+	PlayerIndexes []int `json:"player_indexes"`
+	// ScratchTotal is the sum of the total scores of the players, including blind scores
+	ScratchTotal  int `json:"scratch_total"`
+	HandicapTotal int `json:"handicap_total"`
+}
+
+// getTeamScores sums the scores of the players of each team
+func getTeamScores(teams []Team, players []PlayerScore) []TeamScore {
+	var res []TeamScore
+	first := 0
+	for _, e := range teams {
+		team := TeamScore{Name: e.Name}
+		for i := first; i < first+e.NumPlayers && i < len(players); i++ {
+			team.PlayerIndexes = append(team.PlayerIndexes, i)
+			team.ScratchTotal += players[i].TotalScore
+			team.HandicapTotal += players[i].HandicapTotal
+		}
+		res = append(res, team)
+		first += e.NumPlayers
+	}
+	return res
+}
+
+// TeamSeries contains the totals of a team over the games of a series
+type TeamSeries struct {
+	Name string `json:"name"`
+	// GameScores contains the scratch total of the team in each game
+	GameScores    []int `json:"game_scores"`
+	ScratchTotal  int   `json:"scratch_total"`
+	HandicapTotal int   `json:"handicap_total"`
+}
@@ -0,0 +1,87 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"bowling-score-tracker/configs"
+)
+
+func TestValidateTeams(t *testing.T) {
+	t.Run("should_accept_game_without_teams", func(t *testing.T) {
+		assert.NoError(t, validateTeams(nil, 3, 5))
+	})
+
+	t.Run("should_reject_invalid_teams", func(t *testing.T) {
+		assert.Error(t, validateTeams([]Team{{Name: "A", NumPlayers: 2}}, 2, 5), "at least 2 teams")
+		assert.Error(t, validateTeams([]Team{{Name: "A", NumPlayers: 1}, {NumPlayers: 1}}, 2, 5), "empty name")
+		assert.Error(t, validateTeams([]Team{{Name: "A", NumPlayers: 6}, {Name: "B", NumPlayers: 1}}, 7, 5), "too many players")
+		assert.Error(t, validateTeams([]Team{{Name: "A", NumPlayers: 2}, {Name: "B", NumPlayers: 1}}, 4, 5), "players don't match")
+	})
+}
+
+func TestTenPinGame_Teams(t *testing.T) {
+	t.Run("should_start_team_game_on_lane_pair", func(t *testing.T) {
+		game := newTenPinGame(GameOptions{Teams: []Team{{Name: "A", NumPlayers: 5}, {Name: "B", NumPlayers: 5}}})
+
+		err := game.StartGame([]string{"a1", "a2", "a3", "a4", "a5", "b1", "b2", "b3", "b4", "b5"})
+
+		require.NoError(t, err)
+		assert.Len(t, game.GetPlayers(), 10)
+		assert.Error(t, game.AddPlayer("c1", nil), "late players can't be added to team games")
+	})
+
+	t.Run("should_reject_too_many_players_in_a_team", func(t *testing.T) {
+		game := newTenPinGame(GameOptions{Teams: []Team{{Name: "A", NumPlayers: 6}, {Name: "B", NumPlayers: 1}}})
+
+		assert.Error(t, game.StartGame([]string{"a1", "a2", "a3", "a4", "a5", "a6", "b1"}))
+	})
+}
+
+func TestGameManager_Teams(t *testing.T) {
+	t.Run("should_return_team_totals_per_game_and_series", func(t *testing.T) {
+		m := NewGameManager()
+		startGameRes, err := m.StartGame(configs.TenPin, []string{"hung", "thuy", "lan"}, GameOptions{
+			Teams:        []Team{{Name: "A", NumPlayers: 2}, {Name: "B", NumPlayers: 1}},
+			HandicapRule: HandicapRule{Fixed: 10},
+		})
+		require.NoError(t, err)
+		_, err = m.MarkBlind(startGameRes.Id, 1, lo.ToPtr(100))
+		require.NoError(t, err)
+		playGame(t, m, startGameRes.Id, []int{4, 5})
+		_, err = m.SetFrameResult(startGameRes.Id, 2, 3, 4)
+		require.NoError(t, err)
+
+		res, err := m.GetGame(startGameRes.Id)
+
+		require.NoError(t, err)
+		assert.Equal(t, []TeamScore{
+			// the blind score counts in the team total
+			{Name: "A", PlayerIndexes: []int{0, 1}, ScratchTotal: 190, HandicapTotal: 210},
+			{Name: "B", PlayerIndexes: []int{2}, ScratchTotal: 7, HandicapTotal: 17},
+		}, res.Teams)
+
+		assert.Empty(t, res.SeriesTeams, "game is not in a series yet")
+
+		nextGameRes, err := m.StartNextGame(startGameRes.Id)
+		require.NoError(t, err)
+		assert.Len(t, nextGameRes.Teams, 2)
+
+		series, err := m.GetSeries(nextGameRes.Id)
+		require.NoError(t, err)
+		assert.Equal(t, []TeamSeries{
+			{Name: "A", GameScores: []int{190, 0}, ScratchTotal: 190, HandicapTotal: 230},
+			{Name: "B", GameScores: []int{7, 0}, ScratchTotal: 7, HandicapTotal: 27},
+		}, series.Teams)
+		assert.Equal(t, series.Teams, nextGameRes.SeriesTeams)
+		res, err = m.GetGame(startGameRes.Id)
+		require.NoError(t, err)
+		assert.Equal(t, series.Teams, res.SeriesTeams)
+		assert.Equal(t, lo.Map(series.Players, func(item PlayerSeries, index int) string {
+			return item.Name
+		}), []string{"hung", "thuy", "lan"})
+	})
+}
@@ -1,6 +1,7 @@
 package http_handlers
 
 import (
+	"crypto/subtle"
 	"errors"
 	"net/http"
 	"strconv"
@@ -11,13 +12,53 @@ import (
 	"bowling-score-tracker/core"
 )
 
-func RegisterEndpoints(r *gin.Engine) {
+// RegisterEndpoints registers the endpoints of the app.
+// Privileged endpoints require the adminToken in the X-Admin-Token header, and are disabled if adminToken is empty.
+func RegisterEndpoints(r *gin.Engine, adminToken string) {
 	gameHandler := NewGameHttpHandler(core.NewGameManager())
+	r.GET("/game_types", gameHandler.GetGameTypes)
 	r.POST("/start_game", gameHandler.StartGame)
 	r.GET("/:game_id", gameHandler.GetGame)
 	// HTTP endpoint for setting the result of a player at a specific playerIndex in the current frame of the game
 	r.POST("/:game_id/set_frame_result", gameHandler.SetFrameResult)
+	// HTTP endpoint for adding a roll of a player to the current frame of the game, eg from a lane tablet
+	r.POST("/:game_id/roll", gameHandler.Roll)
 	r.POST("/:game_id/next_frame", gameHandler.NextFrame)
+	// HTTP endpoint for moving a player to their next frame, when players bowl at different paces
+	r.POST("/:game_id/next_player_frame", gameHandler.NextPlayerFrame)
+	r.POST("/:game_id/abandon", gameHandler.AbandonGame)
+	// HTTP endpoints for changing the players of a game in progress
+	r.POST("/:game_id/add_player", gameHandler.AddPlayer)
+	r.POST("/:game_id/withdraw_player", gameHandler.WithdrawPlayer)
+	r.POST("/:game_id/substitute_player", gameHandler.SubstitutePlayer)
+	// HTTP endpoint for scoring an absent player with the blind rule of the game
+	r.POST("/:game_id/mark_blind", gameHandler.MarkBlind)
+	// HTTP endpoints for starting the next game of a series with the same players, and getting the series totals
+	r.POST("/:game_id/next_game", gameHandler.StartNextGame)
+	r.GET("/:game_id/series", gameHandler.GetSeries)
+	// HTTP endpoint for starting a roll-off between the tied players of a completed game
+	r.POST("/:game_id/roll_off", gameHandler.StartRollOff)
+	// HTTP endpoints for undoing and redoing the last operation of the game, eg setting a frame result or the next frame
+	r.POST("/:game_id/undo", gameHandler.Undo)
+	r.POST("/:game_id/redo", gameHandler.Redo)
+	// privileged HTTP endpoints for correcting the result of a previously entered frame, and undoing or redoing corrections
+	r.POST("/:game_id/correct_frame", RequireAdminToken(adminToken), gameHandler.CorrectFrame)
+	r.POST("/:game_id/undo_correction", RequireAdminToken(adminToken), gameHandler.UndoCorrection)
+	r.POST("/:game_id/redo_correction", RequireAdminToken(adminToken), gameHandler.RedoCorrection)
+}
+
+// RequireAdminToken rejects the requests without the adminToken in the X-Admin-Token header
+func RequireAdminToken(adminToken string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.GetHeader("X-Admin-Token")
+		if adminToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) != 1 {
+			c.AbortWithStatusJSON(http.StatusForbidden, Response{
+				Error: "admin token is required",
+			})
+			return
+		}
+		c.Next()
+	}
 }
 
 type GameHttpHandler struct {
@@ -32,15 +73,76 @@ func NewGameHttpHandler(manager GameManager) *GameHttpHandler {
 
 //go:generate mockgen -source=http_handlers.go -destination=mocks/http_handlers.go -package=mocks
 type GameManager interface {
-	StartGame(t configs.GameType, playerNames []string) (core.GameInfo, error)
+	GetGameTypes() []core.GameTypeInfo
+	StartGame(t configs.GameType, playerNames []string, opts core.GameOptions) (core.GameInfo, error)
 	GetGame(gameId int32) (core.GameInfo, error)
 	SetFrameResult(gameId int32, playerIndex int, pins ...int) (core.GameInfo, error)
+	SetFramePins(gameId int32, playerIndex int, knocked ...core.PinMask) (core.GameInfo, error)
+	SetFrameLeaves(gameId int32, playerIndex int, leaves ...core.PinMask) (core.GameInfo, error)
+	Roll(gameId int32, playerIndex int, pins int) (core.GameInfo, bool, error)
+	RollPins(gameId int32, playerIndex int, knocked core.PinMask) (core.GameInfo, bool, error)
+	RollBy(gameId int32, playerIndex int, bowler string, pins int) (core.GameInfo, bool, error)
+	RollPinsBy(gameId int32, playerIndex int, bowler string, knocked core.PinMask) (core.GameInfo, bool, error)
 	NextFrame(gameId int32) (core.GameInfo, error)
+	NextPlayerFrame(gameId int32, playerIndex int) (core.GameInfo, error)
+	CorrectFrame(gameId int32, editor string, playerIndex int, frameIndex int, pins ...int) (core.GameInfo, error)
+	CorrectFramePins(gameId int32, editor string, playerIndex int, frameIndex int, knocked ...core.PinMask) (core.GameInfo, error)
+	CorrectFrameLeaves(gameId int32, editor string, playerIndex int, frameIndex int, leaves ...core.PinMask) (core.GameInfo, error)
+	AbandonGame(gameId int32) (core.GameInfo, error)
+	AddPlayer(gameId int32, name string, average *int) (core.GameInfo, error)
+	WithdrawPlayer(gameId int32, playerIndex int) (core.GameInfo, error)
+	SubstitutePlayer(gameId int32, playerIndex int, name string, fromFrame int) (core.GameInfo, error)
+	SubstitutePartner(gameId int32, playerIndex int, partner string, name string, fromFrame int) (core.GameInfo, error)
+	MarkBlind(gameId int32, playerIndex int, average *int) (core.GameInfo, error)
+	StartRollOff(gameId int32, place int, format configs.RollOffFormat) (core.GameInfo, error)
+	StartNextGame(gameId int32) (core.GameInfo, error)
+	GetSeries(gameId int32) (core.SeriesInfo, error)
+	Undo(gameId int32) (core.GameInfo, error)
+	Redo(gameId int32) (core.GameInfo, error)
+	UndoCorrection(gameId int32, editor string) (core.GameInfo, error)
+	RedoCorrection(gameId int32, editor string) (core.GameInfo, error)
+}
+
+type GameTypesResponse struct {
+	GameTypes []core.GameTypeInfo `json:"game_types"`
+	Response
+}
+
+func (h *GameHttpHandler) GetGameTypes(c *gin.Context) {
+	c.JSON(http.StatusOK, GameTypesResponse{
+		GameTypes: h.manager.GetGameTypes(),
+	})
 }
 
 type StartGameRequest struct {
 	GameType    configs.GameType `json:"game_type"`
-	PlayerNames []string         `json:"player_names" binding:"required,dive,max=5"`
+	PlayerNames []string         `json:"player_names" binding:"required_without=Teams,excluded_with=Teams,dive,max=5"`
+	// TapThreshold is the number of pins with the first ball which counts as a strike in TEN_PIN_NO_TAP games
+	TapThreshold int `json:"tap_threshold" binding:"omitempty,min=8,max=9"`
+	// ScoringSystem is TRADITIONAL (default) or CURRENT_FRAME
+	ScoringSystem configs.ScoringSystem `json:"scoring_system"`
+	// Rules are the rules of CUSTOM games
+	Rules *core.Rules `json:"rules"`
+	// BlindRule computes the score of the players marked blind, eg {"penalty": 10} or {"score": 120}
+	BlindRule core.BlindRule `json:"blind_rule"`
+	// HandicapRule computes the handicap of the players, eg {"base": 220, "percentage": 90, "max": 60} or {"fixed": 20}
+	HandicapRule core.HandicapRule `json:"handicap_rule"`
+	// Players contains the average or the explicit handicap of the players, in the same order as PlayerNames,
+	// or as the players of Teams
+	Players []core.PlayerOptions `json:"players"`
+	// Teams contains the teams of a team game with the names of their players, which are used instead of PlayerNames
+	Teams []TeamRequest `json:"teams" binding:"omitempty,dive"`
+	// BakerOrder contains the bowlers of each team of a BAKER game in the order they bowl the frames,
+	// in the same order as PlayerNames, which are the names of the teams
+	BakerOrder [][]string `json:"baker_order" binding:"omitempty,dive,dive,max=5"`
+	// Partners contains the 2 partners of each pair of a SCOTCH_DOUBLES game, starting with the partner who leads off,
+	// in the same order as PlayerNames, which are the names of the pairs
+	Partners [][]string `json:"partners" binding:"omitempty,dive,len=2,dive,max=5"`
+}
+
+type TeamRequest struct {
+	Name        string   `json:"name" binding:"required"`
+	PlayerNames []string `json:"player_names" binding:"required,dive,max=5"`
 }
 
 type Response struct {
@@ -59,7 +161,22 @@ func (h *GameHttpHandler) StartGame(c *gin.Context) {
 		return
 	}
 
-	res, err := h.manager.StartGame(req.GameType, req.PlayerNames)
+	var teams []core.Team
+	for _, e := range req.Teams {
+		teams = append(teams, core.Team{Name: e.Name, NumPlayers: len(e.PlayerNames)})
+		req.PlayerNames = append(req.PlayerNames, e.PlayerNames...)
+	}
+	res, err := h.manager.StartGame(req.GameType, req.PlayerNames, core.GameOptions{
+		TapThreshold:  req.TapThreshold,
+		ScoringSystem: req.ScoringSystem,
+		Rules:         req.Rules,
+		BlindRule:     req.BlindRule,
+		HandicapRule:  req.HandicapRule,
+		Players:       req.Players,
+		Teams:         teams,
+		BakerOrder:    req.BakerOrder,
+		Partners:      req.Partners,
+	})
 	if err != nil {
 		c.JSON(http.StatusBadRequest, GameResponse{
 			Response: Response{
@@ -105,7 +222,15 @@ func (h *GameHttpHandler) GetGame(c *gin.Context) {
 
 type SetFrameResultRequest struct {
 	PlayerIndex int      `json:"player_index" binding:"min=0"`
-	Pins        []string `json:"pins" binding:"required,dive"`
+	Pins        []string `json:"pins" binding:"required_without_all=KnockedPins StandingPins,dive"`
+	// KnockedPins contains the numbers (from 1) of the pins knocked by each roll, eg [[1, 2], [3, 4, 5]].
+	// It can be used instead of Pins.
+	KnockedPins [][]int `json:"knocked_pins" binding:"omitempty,dive,dive,min=1,max=16"`
+	// StandingPins contains the numbers of the pins left standing after each roll, eg [[7, 10], []] for a 7-10 split
+	// converted to a spare. It can be used instead of KnockedPins.
+	StandingPins [][]int `json:"standing_pins" binding:"omitempty,dive,dive,min=1,max=16"`
+	// Fouls contains the indexes of the foul rolls in KnockedPins or StandingPins, whose pins are ignored
+	Fouls []int `json:"fouls" binding:"omitempty,dive,min=0"`
 }
 
 func (h *GameHttpHandler) SetFrameResult(c *gin.Context) {
@@ -130,16 +255,124 @@ func (h *GameHttpHandler) SetFrameResult(c *gin.Context) {
 		return
 	}
 
-	pins, err := parsePins(req.Pins)
+	var res core.GameInfo
+	if len(req.KnockedPins) > 0 || len(req.StandingPins) > 0 {
+		var knocked, leaves []core.PinMask
+		knocked, err = parseFoulPinMasks(req.KnockedPins, req.Fouls)
+		if err == nil {
+			leaves, err = parseFoulPinMasks(req.StandingPins, req.Fouls)
+		}
+		if err != nil {
+			c.JSON(http.StatusBadRequest, GameResponse{
+				Response: Response{
+					Error: err.Error(),
+				},
+			})
+			return
+		}
+
+		if len(knocked) > 0 {
+			res, err = h.manager.SetFramePins(gameId, req.PlayerIndex, knocked...)
+		} else {
+			res, err = h.manager.SetFrameLeaves(gameId, req.PlayerIndex, leaves...)
+		}
+	} else {
+		var pins []int
+		pins, err = parsePins(req.Pins)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, GameResponse{
+				Response: Response{
+					Error: err.Error(),
+				},
+			})
+			return
+		}
+
+		res, err = h.manager.SetFrameResult(gameId, req.PlayerIndex, pins...)
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, GameResponse{
+		GameInfo: &res,
+	})
+}
+
+type CorrectFrameRequest struct {
+	// Editor is the name of the staff correcting the frame, which is recorded in the audit log
+	Editor      string   `json:"editor" binding:"required"`
+	PlayerIndex int      `json:"player_index" binding:"min=0"`
+	FrameIndex  int      `json:"frame_index" binding:"min=0"`
+	Pins        []string `json:"pins" binding:"required_without_all=KnockedPins StandingPins,dive"`
+	// KnockedPins and StandingPins are the same as in SetFrameResultRequest
+	KnockedPins  [][]int `json:"knocked_pins" binding:"omitempty,dive,dive,min=1,max=16"`
+	StandingPins [][]int `json:"standing_pins" binding:"omitempty,dive,dive,min=1,max=16"`
+	Fouls        []int   `json:"fouls" binding:"omitempty,dive,min=0"`
+}
+
+func (h *GameHttpHandler) CorrectFrame(c *gin.Context) {
+	var req CorrectFrameRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	gameId, err := parseGameId(c)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, GameResponse{
 			Response: Response{
 				Error: err.Error(),
 			},
 		})
+		return
 	}
 
-	res, err := h.manager.SetFrameResult(gameId, req.PlayerIndex, pins...)
+	var res core.GameInfo
+	if len(req.KnockedPins) > 0 || len(req.StandingPins) > 0 {
+		var knocked, leaves []core.PinMask
+		knocked, err = parseFoulPinMasks(req.KnockedPins, req.Fouls)
+		if err == nil {
+			leaves, err = parseFoulPinMasks(req.StandingPins, req.Fouls)
+		}
+		if err != nil {
+			c.JSON(http.StatusBadRequest, GameResponse{
+				Response: Response{
+					Error: err.Error(),
+				},
+			})
+			return
+		}
+
+		if len(knocked) > 0 {
+			res, err = h.manager.CorrectFramePins(gameId, req.Editor, req.PlayerIndex, req.FrameIndex, knocked...)
+		} else {
+			res, err = h.manager.CorrectFrameLeaves(gameId, req.Editor, req.PlayerIndex, req.FrameIndex, leaves...)
+		}
+	} else {
+		var pins []int
+		pins, err = parsePins(req.Pins)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, GameResponse{
+				Response: Response{
+					Error: err.Error(),
+				},
+			})
+			return
+		}
+
+		res, err = h.manager.CorrectFrame(gameId, req.Editor, req.PlayerIndex, req.FrameIndex, pins...)
+	}
 	if err != nil {
 		c.JSON(http.StatusBadRequest, GameResponse{
 			Response: Response{
@@ -154,47 +387,150 @@ func (h *GameHttpHandler) SetFrameResult(c *gin.Context) {
 	})
 }
 
+// parsePins converts the notation of each roll to a number of pins.
+// X and / are converted to core.Strike and core.Spare, whose numbers of pins depend on the rack of the game.
 func parsePins(pins []string) ([]int, error) {
 	var res []int
-	for i, str := range pins {
+	for _, str := range pins {
 		pin, err := parsePin(str)
 		if err != nil {
 			return nil, err
 		}
-		if pin == spare {
-			if i != 1 {
-				return nil, errors.New("/ must be at index 1")
-			}
-			res = append(res, 10-res[i-1])
-		} else {
-			res = append(res, pin)
-		}
+		res = append(res, pin)
 	}
 	return res, nil
 }
 
-const spare = -1
-
 func parsePin(pin string) (int, error) {
 	switch pin {
 	case "X":
-		return 10, nil
+		return core.Strike, nil
 	case "/":
-		return spare, nil
+		return core.Spare, nil
 	case "-":
 		return 0, nil
+	case "F":
+		return core.Foul, nil
 	default:
 		i, err := strconv.Atoi(pin)
 		if err != nil {
 			return 0, err
 		}
-		if i < 0 || i >= 10 {
-			return 0, errors.New("pin must be X, /, -, or between 0 and 10")
+		if i < 0 || i > 16 {
+			return 0, errors.New("pin must be X, /, -, F, or a number of pins")
 		}
 		return i, nil
 	}
 }
 
+// parsePinMasks converts the numbers of the pins of each roll to pin masks
+func parsePinMasks(pinsByRoll [][]int) []core.PinMask {
+	var res []core.PinMask
+	for _, pins := range pinsByRoll {
+		var mask core.PinMask
+		for _, pin := range pins {
+			mask |= 1 << (pin - 1)
+		}
+		res = append(res, mask)
+	}
+	return res
+}
+
+// parseFoulPinMasks is the same as parsePinMasks, but replaces the pins of the foul rolls with core.FoulMask.
+// It returns nil if there is no roll.
+func parseFoulPinMasks(pinsByRoll [][]int, fouls []int) ([]core.PinMask, error) {
+	if len(pinsByRoll) == 0 {
+		return nil, nil
+	}
+
+	res := parsePinMasks(pinsByRoll)
+	for _, e := range fouls {
+		if e >= len(res) {
+			return nil, errors.New("fouls must be indexes of rolls")
+		}
+		res[e] = core.FoulMask
+	}
+	return res, nil
+}
+
+type RollRequest struct {
+	PlayerIndex int `json:"player_index" binding:"min=0"`
+	// Pins is the number of pins knocked by the roll
+	Pins *int `json:"pins" binding:"required_without_all=Foul KnockedPins,omitempty,min=0,max=16"`
+	// KnockedPins contains the numbers (from 1) of the pins knocked by the roll, eg [1, 2].
+	// It can be used instead of Pins.
+	KnockedPins []int `json:"knocked_pins" binding:"omitempty,dive,min=1,max=16"`
+	// Foul is whether the roll is a foul, which knocks no pin. The knocked pins of a foul are ignored.
+	Foul bool `json:"foul"`
+	// Bowler is the bowler of the roll, eg a partner in SCOTCH_DOUBLES games.
+	// When it is set, the roll is rejected if it isn't the turn of the bowler.
+	Bowler string `json:"bowler"`
+}
+
+type RollResponse struct {
+	GameResponse
+	// FrameComplete is whether all balls of the current frame of the player are rolled
+	FrameComplete bool `json:"frame_complete"`
+}
+
+func (h *GameHttpHandler) Roll(c *gin.Context) {
+	var req RollRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	gameId, err := parseGameId(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	pins := core.Foul
+	if !req.Foul && req.Pins != nil {
+		pins = *req.Pins
+	}
+	var res core.GameInfo
+	var frameComplete bool
+	if req.KnockedPins != nil {
+		knocked := core.FoulMask
+		if !req.Foul {
+			knocked = parsePinMasks([][]int{req.KnockedPins})[0]
+		}
+		if req.Bowler != "" {
+			res, frameComplete, err = h.manager.RollPinsBy(gameId, req.PlayerIndex, req.Bowler, knocked)
+		} else {
+			res, frameComplete, err = h.manager.RollPins(gameId, req.PlayerIndex, knocked)
+		}
+	} else if req.Bowler != "" {
+		res, frameComplete, err = h.manager.RollBy(gameId, req.PlayerIndex, req.Bowler, pins)
+	} else {
+		res, frameComplete, err = h.manager.Roll(gameId, req.PlayerIndex, pins)
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, RollResponse{
+		GameResponse:  GameResponse{GameInfo: &res},
+		FrameComplete: frameComplete,
+	})
+}
+
 func (h *GameHttpHandler) NextFrame(c *gin.Context) {
 	gameId, err := parseGameId(c)
 	if err != nil {
@@ -221,6 +557,488 @@ func (h *GameHttpHandler) NextFrame(c *gin.Context) {
 	})
 }
 
+type NextPlayerFrameRequest struct {
+	PlayerIndex int `json:"player_index" binding:"min=0"`
+}
+
+func (h *GameHttpHandler) NextPlayerFrame(c *gin.Context) {
+	var req NextPlayerFrameRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	gameId, err := parseGameId(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	res, err := h.manager.NextPlayerFrame(gameId, req.PlayerIndex)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, GameResponse{
+		GameInfo: &res,
+	})
+}
+
+func (h *GameHttpHandler) AbandonGame(c *gin.Context) {
+	gameId, err := parseGameId(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	res, err := h.manager.AbandonGame(gameId)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, GameResponse{
+		GameInfo: &res,
+	})
+}
+
+type AddPlayerRequest struct {
+	Name string `json:"name" binding:"required,max=5"`
+	// Average is the average of the late bowler, which the blind rule of the game scores the frames before the current
+	// frame of the game with. The frames are left empty if it is not set, unless the blind rule has a fixed score.
+	Average *int `json:"average" binding:"omitempty,min=0"`
+}
+
+func (h *GameHttpHandler) AddPlayer(c *gin.Context) {
+	var req AddPlayerRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	gameId, err := parseGameId(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	res, err := h.manager.AddPlayer(gameId, req.Name, req.Average)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, GameResponse{
+		GameInfo: &res,
+	})
+}
+
+type WithdrawPlayerRequest struct {
+	PlayerIndex int `json:"player_index" binding:"min=0"`
+}
+
+func (h *GameHttpHandler) WithdrawPlayer(c *gin.Context) {
+	var req WithdrawPlayerRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	gameId, err := parseGameId(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	res, err := h.manager.WithdrawPlayer(gameId, req.PlayerIndex)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, GameResponse{
+		GameInfo: &res,
+	})
+}
+
+type SubstitutePlayerRequest struct {
+	PlayerIndex int    `json:"player_index" binding:"min=0"`
+	Name        string `json:"name" binding:"required,max=5"`
+	// FromFrame is the first frame bowled by the substitute
+	FromFrame int `json:"from_frame" binding:"min=0"`
+	// Partner is the replaced partner of a pair in SCOTCH_DOUBLES games
+	Partner string `json:"partner" binding:"max=5"`
+}
+
+func (h *GameHttpHandler) SubstitutePlayer(c *gin.Context) {
+	var req SubstitutePlayerRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	gameId, err := parseGameId(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	var res core.GameInfo
+	if req.Partner != "" {
+		res, err = h.manager.SubstitutePartner(gameId, req.PlayerIndex, req.Partner, req.Name, req.FromFrame)
+	} else {
+		res, err = h.manager.SubstitutePlayer(gameId, req.PlayerIndex, req.Name, req.FromFrame)
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, GameResponse{
+		GameInfo: &res,
+	})
+}
+
+type MarkBlindRequest struct {
+	PlayerIndex int `json:"player_index" binding:"min=0"`
+	// Average is the average of the absent bowler, which is used by the blind rule of the game.
+	// Default to the average of the player set when starting the game.
+	Average *int `json:"average" binding:"omitempty,min=0"`
+}
+
+func (h *GameHttpHandler) MarkBlind(c *gin.Context) {
+	var req MarkBlindRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	gameId, err := parseGameId(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	res, err := h.manager.MarkBlind(gameId, req.PlayerIndex, req.Average)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, GameResponse{
+		GameInfo: &res,
+	})
+}
+
+type StartRollOffRequest struct {
+	// Place is the place of the tied players in the game. Default to 1.
+	Place int `json:"place" binding:"omitempty,min=1"`
+	// Format is NINTH_TENTH_FRAME or ONE_BALL
+	Format configs.RollOffFormat `json:"format" binding:"required"`
+}
+
+func (h *GameHttpHandler) StartRollOff(c *gin.Context) {
+	var req StartRollOffRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	gameId, err := parseGameId(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	if req.Place == 0 {
+		req.Place = 1
+	}
+	res, err := h.manager.StartRollOff(gameId, req.Place, req.Format)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, GameResponse{
+		GameInfo: &res,
+	})
+}
+
+func (h *GameHttpHandler) StartNextGame(c *gin.Context) {
+	gameId, err := parseGameId(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	res, err := h.manager.StartNextGame(gameId)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, GameResponse{
+		GameInfo: &res,
+	})
+}
+
+type SeriesResponse struct {
+	*core.SeriesInfo `json:"series,omitempty"`
+	Response
+}
+
+func (h *GameHttpHandler) GetSeries(c *gin.Context) {
+	gameId, err := parseGameId(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, SeriesResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	res, err := h.manager.GetSeries(gameId)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, SeriesResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, SeriesResponse{
+		SeriesInfo: &res,
+	})
+}
+
+func (h *GameHttpHandler) Undo(c *gin.Context) {
+	gameId, err := parseGameId(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	res, err := h.manager.Undo(gameId)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, GameResponse{
+		GameInfo: &res,
+	})
+}
+
+func (h *GameHttpHandler) Redo(c *gin.Context) {
+	gameId, err := parseGameId(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	res, err := h.manager.Redo(gameId)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, GameResponse{
+		GameInfo: &res,
+	})
+}
+
+type EditorRequest struct {
+	// Editor is the name of the staff undoing or redoing a correction, which is recorded in the audit log
+	Editor string `json:"editor" binding:"required"`
+}
+
+func (h *GameHttpHandler) UndoCorrection(c *gin.Context) {
+	var req EditorRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	gameId, err := parseGameId(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	res, err := h.manager.UndoCorrection(gameId, req.Editor)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, GameResponse{
+		GameInfo: &res,
+	})
+}
+
+func (h *GameHttpHandler) RedoCorrection(c *gin.Context) {
+	var req EditorRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	gameId, err := parseGameId(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	res, err := h.manager.RedoCorrection(gameId, req.Editor)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, GameResponse{
+			Response: Response{
+				Error: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, GameResponse{
+		GameInfo: &res,
+	})
+}
+
 func parseGameId(c *gin.Context) (int32, error) {
 	// Get the "id" parameter from the path.
 	idParam := c.Param("game_id")
@@ -10,6 +10,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -19,6 +20,27 @@ import (
 )
 
 func TestGameHttpHandler(t *testing.T) {
+	t.Run("GetGameTypes", func(t *testing.T) {
+		t.Run("should_return_game_types_of_manager", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.GET("/game_types", handler.GetGameTypes)
+
+			gameTypes := []core.GameTypeInfo{{GameType: configs.TenPin, DisplayName: "10-pin", MaxPlayers: 5}}
+			mockManager.EXPECT().GetGameTypes().Return(gameTypes)
+
+			req, _ := http.NewRequest(http.MethodGet, "/game_types", nil)
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var response GameTypesResponse
+			require.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			assert.Equal(t, gameTypes, response.GameTypes)
+		})
+	})
+
 	t.Run("StartGame", func(t *testing.T) {
 		t.Run("should_return_bad_request_when_input_data_cant_be_parsed", func(t *testing.T) {
 			r := gin.Default()
@@ -61,16 +83,116 @@ func TestGameHttpHandler(t *testing.T) {
 
 			t.Run("should_start_game_with_correct_data", func(t *testing.T) {
 				// verify
-				mock.EXPECT().StartGame(data.GameType, data.PlayerNames).Times(1)
+				mock.EXPECT().StartGame(data.GameType, data.PlayerNames, core.GameOptions{}).Times(1)
+
+				// execute
+				recorder := httptest.NewRecorder()
+				req, _ := http.NewRequest(http.MethodPost, "/start", bytes.NewBuffer(body))
+				r.ServeHTTP(recorder, req)
+			})
+			t.Run("should_pass_rules_of_custom_game", func(t *testing.T) {
+				// setup
+				rules := core.Rules{NumFrames: 5, NumPins: 10, BallsPerFrame: 2, MaxPlayers: 5}
+				customBody, _ := json.Marshal(StartGameRequest{
+					GameType:    configs.Custom,
+					PlayerNames: []string{"hung"},
+					Rules:       &rules,
+				})
+
+				// verify
+				mock.EXPECT().StartGame(configs.Custom, []string{"hung"}, core.GameOptions{Rules: &rules}).Times(1)
+
+				// execute
+				recorder := httptest.NewRecorder()
+				req, _ := http.NewRequest(http.MethodPost, "/start", bytes.NewBuffer(customBody))
+				r.ServeHTTP(recorder, req)
+			})
+			t.Run("should_pass_handicap_of_players", func(t *testing.T) {
+				// setup
+				body := []byte(`{"game_type": "TEN_PIN", "player_names": ["hung", "thuy"],
+					"handicap_rule": {"base": 220, "percentage": 90}, "players": [{"average": 150}, {"handicap": 20}]}`)
+
+				// verify
+				mock.EXPECT().StartGame(configs.TenPin, []string{"hung", "thuy"}, core.GameOptions{
+					HandicapRule: core.HandicapRule{Base: 220, Percentage: 90},
+					Players:      []core.PlayerOptions{{Average: lo.ToPtr(150)}, {Handicap: lo.ToPtr(20)}},
+				}).Times(1)
+
+				// execute
+				recorder := httptest.NewRecorder()
+				req, _ := http.NewRequest(http.MethodPost, "/start", bytes.NewBuffer(body))
+				r.ServeHTTP(recorder, req)
+			})
+			t.Run("should_pass_players_of_teams", func(t *testing.T) {
+				// setup
+				body := []byte(`{"game_type": "TEN_PIN", "teams": [{"name": "A", "player_names": ["hung", "thuy"]},
+					{"name": "B", "player_names": ["lan"]}]}`)
+
+				// verify
+				mock.EXPECT().StartGame(configs.TenPin, []string{"hung", "thuy", "lan"}, core.GameOptions{
+					Teams: []core.Team{{Name: "A", NumPlayers: 2}, {Name: "B", NumPlayers: 1}},
+				}).Times(1)
+
+				// execute
+				recorder := httptest.NewRecorder()
+				req, _ := http.NewRequest(http.MethodPost, "/start", bytes.NewBuffer(body))
+				r.ServeHTTP(recorder, req)
+			})
+			t.Run("should_pass_baker_order", func(t *testing.T) {
+				// setup
+				body := []byte(`{"game_type": "BAKER", "player_names": ["A", "B"], "baker_order": [["a1", "a2"], ["b1"]]}`)
+
+				// verify
+				mock.EXPECT().StartGame(configs.Baker, []string{"A", "B"}, core.GameOptions{
+					BakerOrder: [][]string{{"a1", "a2"}, {"b1"}},
+				}).Times(1)
+
+				// execute
+				recorder := httptest.NewRecorder()
+				req, _ := http.NewRequest(http.MethodPost, "/start", bytes.NewBuffer(body))
+				r.ServeHTTP(recorder, req)
+			})
+			t.Run("should_pass_partners_of_pairs", func(t *testing.T) {
+				// setup
+				body := []byte(`{"game_type": "SCOTCH_DOUBLES", "player_names": ["A"], "partners": [["hung", "thuy"]]}`)
+
+				// verify
+				mock.EXPECT().StartGame(configs.ScotchDoubles, []string{"A"}, core.GameOptions{
+					Partners: [][]string{{"hung", "thuy"}},
+				}).Times(1)
+
+				// execute
+				recorder := httptest.NewRecorder()
+				req, _ := http.NewRequest(http.MethodPost, "/start", bytes.NewBuffer(body))
+				r.ServeHTTP(recorder, req)
+			})
+			t.Run("should_reject_pair_without_2_partners", func(t *testing.T) {
+				// setup
+				body := []byte(`{"game_type": "SCOTCH_DOUBLES", "player_names": ["A"], "partners": [["hung"]]}`)
+
+				// execute
+				recorder := httptest.NewRecorder()
+				req, _ := http.NewRequest(http.MethodPost, "/start", bytes.NewBuffer(body))
+				r.ServeHTTP(recorder, req)
+
+				// verify
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			})
+			t.Run("should_reject_both_player_names_and_teams", func(t *testing.T) {
+				// setup
+				body := []byte(`{"game_type": "TEN_PIN", "player_names": ["hung"], "teams": [{"name": "A", "player_names": ["thuy"]}]}`)
 
 				// execute
 				recorder := httptest.NewRecorder()
 				req, _ := http.NewRequest(http.MethodPost, "/start", bytes.NewBuffer(body))
 				r.ServeHTTP(recorder, req)
+
+				// verify
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
 			})
 			t.Run("should_return_error_when_failing_to_start_game", func(t *testing.T) {
 				// setup
-				mock.EXPECT().StartGame(gomock.Any(), gomock.Any()).Return(core.GameInfo{}, errors.New("abc"))
+				mock.EXPECT().StartGame(gomock.Any(), gomock.Any(), gomock.Any()).Return(core.GameInfo{}, errors.New("abc"))
 
 				// execute
 				recorder := httptest.NewRecorder()
@@ -82,7 +204,7 @@ func TestGameHttpHandler(t *testing.T) {
 			})
 			t.Run("should_success_with_gameid_when_starting_game_successfully", func(t *testing.T) {
 				// setup
-				mock.EXPECT().StartGame(gomock.Any(), gomock.Any()).Return(core.GameInfo{Id: 4}, nil)
+				mock.EXPECT().StartGame(gomock.Any(), gomock.Any(), gomock.Any()).Return(core.GameInfo{Id: 4}, nil)
 
 				// execute
 				recorder := httptest.NewRecorder()
@@ -200,6 +322,42 @@ func TestGameHttpHandler(t *testing.T) {
 			assert.Equal(t, http.StatusBadRequest, recorder.Code)
 		})
 
+		t.Run("should_return_bad_request_when_knocked_pins_are_invalid", func(t *testing.T) {
+			r := gin.Default()
+			handler := NewGameHttpHandler(nil)
+
+			r.POST("/:game_id/set_frame_result", handler.SetFrameResult)
+
+			invalidReq := SetFrameResultRequest{
+				PlayerIndex: 0,
+				KnockedPins: [][]int{{0}},
+			}
+			bodyInvalid, _ := json.Marshal(invalidReq)
+			req, _ := http.NewRequest(http.MethodPost, "/123/set_frame_result", bytes.NewBuffer(bodyInvalid))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+
+		t.Run("should_return_bad_request_when_foul_is_not_a_roll", func(t *testing.T) {
+			r := gin.Default()
+			handler := NewGameHttpHandler(nil)
+
+			r.POST("/:game_id/set_frame_result", handler.SetFrameResult)
+
+			bodyInvalid, _ := json.Marshal(SetFrameResultRequest{
+				PlayerIndex: 0,
+				KnockedPins: [][]int{{1}, {2}, {}},
+				Fouls:       []int{3},
+			})
+			req, _ := http.NewRequest(http.MethodPost, "/123/set_frame_result", bytes.NewBuffer(bodyInvalid))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+
 		t.Run("when_input_is_valid", func(t *testing.T) {
 			validReq := SetFrameResultRequest{
 				PlayerIndex: 0,
@@ -215,7 +373,7 @@ func TestGameHttpHandler(t *testing.T) {
 			r.POST("/:game_id/set_frame_result", handler.SetFrameResult)
 
 			t.Run("should_call_manager_set_frame_result_with_correct_data", func(t *testing.T) {
-				expectedPins := []interface{}{10, 5}
+				expectedPins := []interface{}{core.Strike, 5}
 
 				mockManager.EXPECT().
 					SetFrameResult(int32(123), validReq.PlayerIndex, expectedPins...).
@@ -228,6 +386,61 @@ func TestGameHttpHandler(t *testing.T) {
 				assert.Equal(t, http.StatusOK, recorder.Code)
 			})
 
+			t.Run("should_call_manager_set_frame_pins_when_knocked_pins_are_set", func(t *testing.T) {
+				body, _ := json.Marshal(SetFrameResultRequest{
+					PlayerIndex: 1,
+					KnockedPins: [][]int{{3}, {1, 2}, {4, 5}},
+				})
+				expectedPins := []interface{}{core.PinMask(0b00100), core.PinMask(0b00011), core.PinMask(0b11000)}
+
+				mockManager.EXPECT().
+					SetFramePins(int32(123), 1, expectedPins...).
+					Return(core.GameInfo{}, nil)
+
+				req, _ := http.NewRequest(http.MethodPost, "/123/set_frame_result", bytes.NewBuffer(body))
+				recorder := httptest.NewRecorder()
+				r.ServeHTTP(recorder, req)
+
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			})
+
+			t.Run("should_call_manager_set_frame_pins_with_fouls", func(t *testing.T) {
+				body, _ := json.Marshal(SetFrameResultRequest{
+					PlayerIndex: 1,
+					KnockedPins: [][]int{{}, {1, 2}, {4, 5}},
+					Fouls:       []int{0},
+				})
+				expectedPins := []interface{}{core.FoulMask, core.PinMask(0b00011), core.PinMask(0b11000)}
+
+				mockManager.EXPECT().
+					SetFramePins(int32(123), 1, expectedPins...).
+					Return(core.GameInfo{}, nil)
+
+				req, _ := http.NewRequest(http.MethodPost, "/123/set_frame_result", bytes.NewBuffer(body))
+				recorder := httptest.NewRecorder()
+				r.ServeHTTP(recorder, req)
+
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			})
+
+			t.Run("should_call_manager_set_frame_leaves_when_standing_pins_are_set", func(t *testing.T) {
+				body, _ := json.Marshal(SetFrameResultRequest{
+					PlayerIndex:  1,
+					StandingPins: [][]int{{7, 10}, {}},
+				})
+				expectedLeaves := []interface{}{core.PinMask(0b1001000000), core.PinMask(0)}
+
+				mockManager.EXPECT().
+					SetFrameLeaves(int32(123), 1, expectedLeaves...).
+					Return(core.GameInfo{}, nil)
+
+				req, _ := http.NewRequest(http.MethodPost, "/123/set_frame_result", bytes.NewBuffer(body))
+				recorder := httptest.NewRecorder()
+				r.ServeHTTP(recorder, req)
+
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			})
+
 			t.Run("should_return_error_when_manager_set_frame_result_fails", func(t *testing.T) {
 				mockManager.EXPECT().
 					SetFrameResult(int32(123), validReq.PlayerIndex, gomock.Any()).
@@ -242,6 +455,112 @@ func TestGameHttpHandler(t *testing.T) {
 		})
 	})
 
+	t.Run("Roll", func(t *testing.T) {
+		t.Run("should_return_bad_request_when_pins_are_missing", func(t *testing.T) {
+			r := gin.Default()
+			handler := NewGameHttpHandler(nil)
+			r.POST("/:game_id/roll", handler.Roll)
+
+			req, _ := http.NewRequest(http.MethodPost, "/123/roll", bytes.NewBuffer([]byte(`{"player_index": 0}`)))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+
+		t.Run("when_input_is_valid", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.POST("/:game_id/roll", handler.Roll)
+			body := []byte(`{"player_index": 1, "pins": 0}`)
+
+			t.Run("should_return_frame_complete_when_manager_roll_succeeds", func(t *testing.T) {
+				mockManager.EXPECT().Roll(int32(123), 1, 0).Return(core.GameInfo{Id: 123}, true, nil)
+
+				req, _ := http.NewRequest(http.MethodPost, "/123/roll", bytes.NewBuffer(body))
+				recorder := httptest.NewRecorder()
+				r.ServeHTTP(recorder, req)
+
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				var response RollResponse
+				require.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				assert.Equal(t, int32(123), response.Id)
+				assert.True(t, response.FrameComplete)
+			})
+
+			t.Run("should_roll_foul", func(t *testing.T) {
+				mockManager.EXPECT().Roll(int32(123), 1, core.Foul).Return(core.GameInfo{Id: 123}, false, nil)
+
+				req, _ := http.NewRequest(http.MethodPost, "/123/roll", bytes.NewBuffer([]byte(`{"player_index": 1, "foul": true}`)))
+				recorder := httptest.NewRecorder()
+				r.ServeHTTP(recorder, req)
+
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			})
+
+			t.Run("should_roll_knocked_pins", func(t *testing.T) {
+				mockManager.EXPECT().RollPins(int32(123), 1, core.PinMask(0b00110)).Return(core.GameInfo{Id: 123}, false, nil)
+
+				req, _ := http.NewRequest(http.MethodPost, "/123/roll", bytes.NewBuffer([]byte(`{"player_index": 1, "knocked_pins": [2, 3]}`)))
+				recorder := httptest.NewRecorder()
+				r.ServeHTTP(recorder, req)
+
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			})
+
+			t.Run("should_roll_foul_with_knocked_pins", func(t *testing.T) {
+				mockManager.EXPECT().RollPins(int32(123), 1, core.FoulMask).Return(core.GameInfo{Id: 123}, false, nil)
+
+				req, _ := http.NewRequest(http.MethodPost, "/123/roll", bytes.NewBuffer([]byte(`{"player_index": 1, "knocked_pins": [3], "foul": true}`)))
+				recorder := httptest.NewRecorder()
+				r.ServeHTTP(recorder, req)
+
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			})
+
+			t.Run("should_roll_no_knocked_pin", func(t *testing.T) {
+				mockManager.EXPECT().RollPins(int32(123), 1, core.PinMask(0)).Return(core.GameInfo{Id: 123}, false, nil)
+
+				req, _ := http.NewRequest(http.MethodPost, "/123/roll", bytes.NewBuffer([]byte(`{"player_index": 1, "knocked_pins": []}`)))
+				recorder := httptest.NewRecorder()
+				r.ServeHTTP(recorder, req)
+
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			})
+
+			t.Run("should_roll_by_bowler", func(t *testing.T) {
+				mockManager.EXPECT().RollBy(int32(123), 1, "thuy", 7).Return(core.GameInfo{Id: 123}, false, nil)
+
+				req, _ := http.NewRequest(http.MethodPost, "/123/roll", bytes.NewBuffer([]byte(`{"player_index": 1, "pins": 7, "bowler": "thuy"}`)))
+				recorder := httptest.NewRecorder()
+				r.ServeHTTP(recorder, req)
+
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			})
+
+			t.Run("should_roll_knocked_pins_by_bowler", func(t *testing.T) {
+				mockManager.EXPECT().RollPinsBy(int32(123), 1, "thuy", core.PinMask(0b00110)).Return(core.GameInfo{Id: 123}, false, nil)
+
+				req, _ := http.NewRequest(http.MethodPost, "/123/roll", bytes.NewBuffer([]byte(`{"player_index": 1, "knocked_pins": [2, 3], "bowler": "thuy"}`)))
+				recorder := httptest.NewRecorder()
+				r.ServeHTTP(recorder, req)
+
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			})
+
+			t.Run("should_return_error_when_manager_roll_fails", func(t *testing.T) {
+				mockManager.EXPECT().Roll(int32(123), 1, 0).Return(core.GameInfo{}, false, errors.New("roll error"))
+
+				req, _ := http.NewRequest(http.MethodPost, "/123/roll", bytes.NewBuffer(body))
+				recorder := httptest.NewRecorder()
+				r.ServeHTTP(recorder, req)
+
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			})
+		})
+	})
+
 	t.Run("NextFrame", func(t *testing.T) {
 		t.Run("should_return_bad_request_when_game_id_is_invalid", func(t *testing.T) {
 			r := gin.Default()
@@ -293,4 +612,582 @@ func TestGameHttpHandler(t *testing.T) {
 			assert.Equal(t, 5, response.CurrentFrame)
 		})
 	})
+
+	t.Run("NextPlayerFrame", func(t *testing.T) {
+		t.Run("should_return_bad_request_when_game_id_is_invalid", func(t *testing.T) {
+			r := gin.Default()
+			handler := NewGameHttpHandler(nil)
+			r.POST("/:game_id/next_player_frame", handler.NextPlayerFrame)
+
+			req, _ := http.NewRequest(http.MethodPost, "/abc/next_player_frame", bytes.NewBuffer([]byte(`{"player_index": 1}`)))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+
+		t.Run("should_call_manager_next_player_frame_with_correct_data", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.POST("/:game_id/next_player_frame", handler.NextPlayerFrame)
+
+			mockManager.EXPECT().NextPlayerFrame(int32(789), 1).Return(core.GameInfo{Id: 789}, nil)
+
+			req, _ := http.NewRequest(http.MethodPost, "/789/next_player_frame", bytes.NewBuffer([]byte(`{"player_index": 1}`)))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+		})
+	})
+
+	t.Run("AbandonGame", func(t *testing.T) {
+		t.Run("should_return_bad_request_when_game_id_is_invalid", func(t *testing.T) {
+			r := gin.Default()
+			handler := NewGameHttpHandler(nil)
+			r.POST("/:game_id/abandon", handler.AbandonGame)
+
+			req, _ := http.NewRequest(http.MethodPost, "/abc/abandon", nil)
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+
+		t.Run("should_return_game_when_manager_abandon_game_succeeds", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.POST("/:game_id/abandon", handler.AbandonGame)
+
+			mockManager.EXPECT().AbandonGame(int32(789)).Return(core.GameInfo{Id: 789, Status: configs.Abandoned}, nil)
+
+			req, _ := http.NewRequest(http.MethodPost, "/789/abandon", nil)
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var response GameResponse
+			require.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			assert.Equal(t, configs.Abandoned, response.Status)
+		})
+	})
+
+	t.Run("AddPlayer", func(t *testing.T) {
+		t.Run("should_return_bad_request_when_name_is_missing", func(t *testing.T) {
+			r := gin.Default()
+			handler := NewGameHttpHandler(nil)
+			r.POST("/:game_id/add_player", handler.AddPlayer)
+
+			req, _ := http.NewRequest(http.MethodPost, "/789/add_player", bytes.NewBuffer([]byte(`{}`)))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+
+		t.Run("should_call_manager_add_player_with_average", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.POST("/:game_id/add_player", handler.AddPlayer)
+
+			mockManager.EXPECT().AddPlayer(int32(789), "thuy", lo.ToPtr(150)).Return(core.GameInfo{Id: 789}, nil)
+
+			req, _ := http.NewRequest(http.MethodPost, "/789/add_player", bytes.NewBuffer([]byte(`{"name": "thuy", "average": 150}`)))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+		})
+	})
+
+	t.Run("WithdrawPlayer", func(t *testing.T) {
+		t.Run("should_return_error_when_manager_withdraw_player_fails", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.POST("/:game_id/withdraw_player", handler.WithdrawPlayer)
+
+			mockManager.EXPECT().WithdrawPlayer(int32(789), 1).Return(core.GameInfo{}, errors.New("player has withdrawn"))
+
+			req, _ := http.NewRequest(http.MethodPost, "/789/withdraw_player", bytes.NewBuffer([]byte(`{"player_index": 1}`)))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+	})
+
+	t.Run("SubstitutePlayer", func(t *testing.T) {
+		t.Run("should_call_manager_substitute_player_with_correct_data", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.POST("/:game_id/substitute_player", handler.SubstitutePlayer)
+
+			mockManager.EXPECT().SubstitutePlayer(int32(789), 0, "lan", 4).Return(core.GameInfo{Id: 789}, nil)
+
+			req, _ := http.NewRequest(http.MethodPost, "/789/substitute_player", bytes.NewBuffer([]byte(`{"player_index": 0, "name": "lan", "from_frame": 4}`)))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+		})
+
+		t.Run("should_call_manager_substitute_partner_when_partner_is_given", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.POST("/:game_id/substitute_player", handler.SubstitutePlayer)
+
+			mockManager.EXPECT().SubstitutePartner(int32(789), 0, "thuy", "lan", 4).Return(core.GameInfo{Id: 789}, nil)
+
+			req, _ := http.NewRequest(http.MethodPost, "/789/substitute_player", bytes.NewBuffer([]byte(`{"player_index": 0, "name": "lan", "from_frame": 4, "partner": "thuy"}`)))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+		})
+	})
+
+	t.Run("MarkBlind", func(t *testing.T) {
+		t.Run("should_return_bad_request_when_average_is_invalid", func(t *testing.T) {
+			r := gin.Default()
+			handler := NewGameHttpHandler(nil)
+			r.POST("/:game_id/mark_blind", handler.MarkBlind)
+
+			req, _ := http.NewRequest(http.MethodPost, "/789/mark_blind", bytes.NewBuffer([]byte(`{"player_index": 1, "average": -1}`)))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+
+		t.Run("should_return_blind_player_when_manager_mark_blind_succeeds", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.POST("/:game_id/mark_blind", handler.MarkBlind)
+
+			mockManager.EXPECT().MarkBlind(int32(789), 1, lo.ToPtr(150)).Return(core.GameInfo{
+				Id:      789,
+				Players: []core.PlayerScore{{Name: "hung"}, {Name: "thuy", Blind: true, TotalScore: 140}},
+			}, nil)
+
+			req, _ := http.NewRequest(http.MethodPost, "/789/mark_blind", bytes.NewBuffer([]byte(`{"player_index": 1, "average": 150}`)))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var response GameResponse
+			require.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			assert.True(t, response.Players[1].Blind)
+		})
+	})
+
+	t.Run("StartRollOff", func(t *testing.T) {
+		t.Run("should_return_bad_request_when_format_is_missing", func(t *testing.T) {
+			r := gin.Default()
+			handler := NewGameHttpHandler(nil)
+			r.POST("/:game_id/roll_off", handler.StartRollOff)
+
+			req, _ := http.NewRequest(http.MethodPost, "/789/roll_off", bytes.NewBuffer([]byte(`{"place": 1}`)))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+
+		t.Run("should_start_roll_off_for_first_place_by_default", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.POST("/:game_id/roll_off", handler.StartRollOff)
+
+			mockManager.EXPECT().StartRollOff(int32(789), 1, configs.OneBall).Return(core.GameInfo{Id: 790, ParentId: 789}, nil)
+
+			req, _ := http.NewRequest(http.MethodPost, "/789/roll_off", bytes.NewBuffer([]byte(`{"format": "ONE_BALL"}`)))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var response GameResponse
+			require.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			assert.Equal(t, int32(789), response.ParentId)
+		})
+
+		t.Run("should_return_error_when_manager_start_roll_off_fails", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.POST("/:game_id/roll_off", handler.StartRollOff)
+
+			mockManager.EXPECT().StartRollOff(int32(789), 2, configs.NinthTenthFrame).Return(core.GameInfo{}, errors.New("no tie"))
+
+			req, _ := http.NewRequest(http.MethodPost, "/789/roll_off", bytes.NewBuffer([]byte(`{"place": 2, "format": "NINTH_TENTH_FRAME"}`)))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+	})
+
+	t.Run("StartNextGame", func(t *testing.T) {
+		t.Run("should_return_next_game_when_manager_start_next_game_succeeds", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.POST("/:game_id/next_game", handler.StartNextGame)
+
+			mockManager.EXPECT().StartNextGame(int32(789)).Return(core.GameInfo{Id: 790, SeriesId: 789}, nil)
+
+			req, _ := http.NewRequest(http.MethodPost, "/789/next_game", nil)
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var response GameResponse
+			require.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			assert.Equal(t, int32(789), response.SeriesId)
+		})
+	})
+
+	t.Run("GetSeries", func(t *testing.T) {
+		t.Run("should_return_bad_request_when_game_id_is_invalid", func(t *testing.T) {
+			r := gin.Default()
+			handler := NewGameHttpHandler(nil)
+			r.GET("/:game_id/series", handler.GetSeries)
+
+			req, _ := http.NewRequest(http.MethodGet, "/abc/series", nil)
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+
+		t.Run("should_return_error_when_manager_get_series_fails", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.GET("/:game_id/series", handler.GetSeries)
+
+			mockManager.EXPECT().GetSeries(int32(789)).Return(core.SeriesInfo{}, errors.New("game is not in a series"))
+
+			req, _ := http.NewRequest(http.MethodGet, "/789/series", nil)
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+
+		t.Run("should_return_series_when_manager_get_series_succeeds", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.GET("/:game_id/series", handler.GetSeries)
+
+			mockManager.EXPECT().GetSeries(int32(789)).Return(core.SeriesInfo{Id: 788, GameIds: []int32{788, 789}}, nil)
+
+			req, _ := http.NewRequest(http.MethodGet, "/789/series", nil)
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var response SeriesResponse
+			require.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			assert.Equal(t, []int32{788, 789}, response.GameIds)
+		})
+	})
+
+	t.Run("Undo", func(t *testing.T) {
+		t.Run("should_return_bad_request_when_game_id_is_invalid", func(t *testing.T) {
+			r := gin.Default()
+			handler := NewGameHttpHandler(nil)
+			r.POST("/:game_id/undo", handler.Undo)
+
+			req, _ := http.NewRequest(http.MethodPost, "/abc/undo", nil)
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+
+		t.Run("should_return_error_when_manager_undo_fails", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.POST("/:game_id/undo", handler.Undo)
+
+			mockManager.EXPECT().Undo(int32(456)).Return(core.GameInfo{}, errors.New("nothing to undo"))
+
+			req, _ := http.NewRequest(http.MethodPost, "/456/undo", nil)
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+
+		t.Run("should_return_game_when_manager_undo_succeeds", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.POST("/:game_id/undo", handler.Undo)
+
+			mockManager.EXPECT().Undo(int32(789)).Return(core.GameInfo{Id: 789}, nil)
+
+			req, _ := http.NewRequest(http.MethodPost, "/789/undo", nil)
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+		})
+	})
+
+	t.Run("Redo", func(t *testing.T) {
+		t.Run("should_return_error_when_manager_redo_fails", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.POST("/:game_id/redo", handler.Redo)
+
+			mockManager.EXPECT().Redo(int32(456)).Return(core.GameInfo{}, errors.New("nothing to redo"))
+
+			req, _ := http.NewRequest(http.MethodPost, "/456/redo", nil)
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+
+		t.Run("should_return_game_when_manager_redo_succeeds", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.POST("/:game_id/redo", handler.Redo)
+
+			mockManager.EXPECT().Redo(int32(789)).Return(core.GameInfo{Id: 789}, nil)
+
+			req, _ := http.NewRequest(http.MethodPost, "/789/redo", nil)
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+		})
+	})
+
+	t.Run("UndoCorrection", func(t *testing.T) {
+		t.Run("should_return_bad_request_when_editor_is_missing", func(t *testing.T) {
+			r := gin.Default()
+			handler := NewGameHttpHandler(nil)
+			r.POST("/:game_id/undo_correction", handler.UndoCorrection)
+
+			req, _ := http.NewRequest(http.MethodPost, "/123/undo_correction", bytes.NewBuffer([]byte(`{}`)))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+
+		t.Run("should_return_game_when_manager_undo_correction_succeeds", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.POST("/:game_id/undo_correction", handler.UndoCorrection)
+
+			mockManager.EXPECT().UndoCorrection(int32(789), "desk").Return(core.GameInfo{Id: 789}, nil)
+
+			req, _ := http.NewRequest(http.MethodPost, "/789/undo_correction", bytes.NewBuffer([]byte(`{"editor": "desk"}`)))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+		})
+	})
+
+	t.Run("RedoCorrection", func(t *testing.T) {
+		t.Run("should_return_error_when_manager_redo_correction_fails", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.POST("/:game_id/redo_correction", handler.RedoCorrection)
+
+			mockManager.EXPECT().RedoCorrection(int32(456), "desk").Return(core.GameInfo{}, errors.New("nothing to redo"))
+
+			req, _ := http.NewRequest(http.MethodPost, "/456/redo_correction", bytes.NewBuffer([]byte(`{"editor": "desk"}`)))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+
+		t.Run("should_return_game_when_manager_redo_correction_succeeds", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.POST("/:game_id/redo_correction", handler.RedoCorrection)
+
+			mockManager.EXPECT().RedoCorrection(int32(789), "desk").Return(core.GameInfo{Id: 789}, nil)
+
+			req, _ := http.NewRequest(http.MethodPost, "/789/redo_correction", bytes.NewBuffer([]byte(`{"editor": "desk"}`)))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+		})
+	})
+
+	t.Run("CorrectFrame", func(t *testing.T) {
+		body, _ := json.Marshal(CorrectFrameRequest{
+			Editor:      "admin",
+			PlayerIndex: 1,
+			FrameIndex:  2,
+			Pins:        []string{"3", "/"},
+		})
+
+		t.Run("should_return_bad_request_when_editor_is_missing", func(t *testing.T) {
+			r := gin.Default()
+			handler := NewGameHttpHandler(nil)
+			r.POST("/:game_id/correct_frame", handler.CorrectFrame)
+
+			req, _ := http.NewRequest(http.MethodPost, "/123/correct_frame", bytes.NewBuffer([]byte(`{"pins": ["X"]}`)))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+
+		t.Run("should_call_manager_correct_frame_with_correct_data", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.POST("/:game_id/correct_frame", handler.CorrectFrame)
+
+			mockManager.EXPECT().CorrectFrame(int32(123), "admin", 1, 2, 3, core.Spare).Return(core.GameInfo{Id: 123}, nil)
+
+			req, _ := http.NewRequest(http.MethodPost, "/123/correct_frame", bytes.NewBuffer(body))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+		})
+
+		t.Run("should_call_manager_correct_frame_pins_with_knocked_pins", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.POST("/:game_id/correct_frame", handler.CorrectFrame)
+
+			mockManager.EXPECT().CorrectFramePins(int32(123), "admin", 1, 2, core.PinMask(0b00100), core.PinMask(0b00011), core.PinMask(0)).
+				Return(core.GameInfo{Id: 123}, nil)
+
+			req, _ := http.NewRequest(http.MethodPost, "/123/correct_frame", bytes.NewBuffer([]byte(
+				`{"editor": "admin", "player_index": 1, "frame_index": 2, "knocked_pins": [[3], [1, 2], []]}`)))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+		})
+
+		t.Run("should_call_manager_correct_frame_leaves_with_standing_pins", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.POST("/:game_id/correct_frame", handler.CorrectFrame)
+
+			mockManager.EXPECT().CorrectFrameLeaves(int32(123), "admin", 1, 2, core.PinMask(0b1001000000), core.PinMask(0)).
+				Return(core.GameInfo{Id: 123}, nil)
+
+			req, _ := http.NewRequest(http.MethodPost, "/123/correct_frame", bytes.NewBuffer([]byte(
+				`{"editor": "admin", "player_index": 1, "frame_index": 2, "standing_pins": [[7, 10], []]}`)))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+		})
+
+		t.Run("should_return_error_when_manager_correct_frame_fails", func(t *testing.T) {
+			r := gin.Default()
+			mockManager := mocks.NewMockGameManager(gomock.NewController(t))
+			handler := NewGameHttpHandler(mockManager)
+			r.POST("/:game_id/correct_frame", handler.CorrectFrame)
+
+			mockManager.EXPECT().CorrectFrame(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(core.GameInfo{}, errors.New("correct frame error"))
+
+			req, _ := http.NewRequest(http.MethodPost, "/123/correct_frame", bytes.NewBuffer(body))
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+	})
+}
+
+func TestParsePins(t *testing.T) {
+	t.Run("should_parse_fouls", func(t *testing.T) {
+		res, err := parsePins([]string{"F", "/"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{core.Foul, core.Spare}, res)
+	})
+
+	t.Run("should_parse_notation", func(t *testing.T) {
+		res, err := parsePins([]string{"7", "/", "X"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{7, core.Spare, core.Strike}, res)
+	})
+
+	t.Run("should_parse_numbers_of_pins_of_larger_racks", func(t *testing.T) {
+		res, err := parsePins([]string{"11", "-"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{11, 0}, res)
+	})
+}
+
+func TestRequireAdminToken(t *testing.T) {
+	newRouter := func(adminToken string) *gin.Engine {
+		r := gin.Default()
+		r.POST("/admin", RequireAdminToken(adminToken), func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+		return r
+	}
+
+	t.Run("should_reject_request_without_admin_token", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/admin", nil)
+		recorder := httptest.NewRecorder()
+		newRouter("secret").ServeHTTP(recorder, req)
+
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+
+	t.Run("should_reject_wrong_admin_token", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/admin", nil)
+		req.Header.Set("X-Admin-Token", "abc")
+		recorder := httptest.NewRecorder()
+		newRouter("secret").ServeHTTP(recorder, req)
+
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+
+	t.Run("should_reject_all_requests_when_admin_token_is_not_configured", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/admin", nil)
+		recorder := httptest.NewRecorder()
+		newRouter("").ServeHTTP(recorder, req)
+
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+
+	t.Run("should_accept_correct_admin_token", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/admin", nil)
+		req.Header.Set("X-Admin-Token", "secret")
+		recorder := httptest.NewRecorder()
+		newRouter("secret").ServeHTTP(recorder, req)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
 }
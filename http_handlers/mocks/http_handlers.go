@@ -35,6 +35,96 @@ func (m *MockGameManager) EXPECT() *MockGameManagerMockRecorder {
 	return m.recorder
 }
 
+// AbandonGame mocks base method.
+func (m *MockGameManager) AbandonGame(gameId int32) (core.GameInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbandonGame", gameId)
+	ret0, _ := ret[0].(core.GameInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AbandonGame indicates an expected call of AbandonGame.
+func (mr *MockGameManagerMockRecorder) AbandonGame(gameId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbandonGame", reflect.TypeOf((*MockGameManager)(nil).AbandonGame), gameId)
+}
+
+// AddPlayer mocks base method.
+func (m *MockGameManager) AddPlayer(gameId int32, name string, average *int) (core.GameInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPlayer", gameId, name, average)
+	ret0, _ := ret[0].(core.GameInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPlayer indicates an expected call of AddPlayer.
+func (mr *MockGameManagerMockRecorder) AddPlayer(gameId, name, average interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPlayer", reflect.TypeOf((*MockGameManager)(nil).AddPlayer), gameId, name, average)
+}
+
+// CorrectFrame mocks base method.
+func (m *MockGameManager) CorrectFrame(gameId int32, editor string, playerIndex, frameIndex int, pins ...int) (core.GameInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{gameId, editor, playerIndex, frameIndex}
+	for _, a := range pins {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CorrectFrame", varargs...)
+	ret0, _ := ret[0].(core.GameInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CorrectFrame indicates an expected call of CorrectFrame.
+func (mr *MockGameManagerMockRecorder) CorrectFrame(gameId, editor, playerIndex, frameIndex interface{}, pins ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{gameId, editor, playerIndex, frameIndex}, pins...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CorrectFrame", reflect.TypeOf((*MockGameManager)(nil).CorrectFrame), varargs...)
+}
+
+// CorrectFrameLeaves mocks base method.
+func (m *MockGameManager) CorrectFrameLeaves(gameId int32, editor string, playerIndex, frameIndex int, leaves ...core.PinMask) (core.GameInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{gameId, editor, playerIndex, frameIndex}
+	for _, a := range leaves {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CorrectFrameLeaves", varargs...)
+	ret0, _ := ret[0].(core.GameInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CorrectFrameLeaves indicates an expected call of CorrectFrameLeaves.
+func (mr *MockGameManagerMockRecorder) CorrectFrameLeaves(gameId, editor, playerIndex, frameIndex interface{}, leaves ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{gameId, editor, playerIndex, frameIndex}, leaves...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CorrectFrameLeaves", reflect.TypeOf((*MockGameManager)(nil).CorrectFrameLeaves), varargs...)
+}
+
+// CorrectFramePins mocks base method.
+func (m *MockGameManager) CorrectFramePins(gameId int32, editor string, playerIndex, frameIndex int, knocked ...core.PinMask) (core.GameInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{gameId, editor, playerIndex, frameIndex}
+	for _, a := range knocked {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CorrectFramePins", varargs...)
+	ret0, _ := ret[0].(core.GameInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CorrectFramePins indicates an expected call of CorrectFramePins.
+func (mr *MockGameManagerMockRecorder) CorrectFramePins(gameId, editor, playerIndex, frameIndex interface{}, knocked ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{gameId, editor, playerIndex, frameIndex}, knocked...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CorrectFramePins", reflect.TypeOf((*MockGameManager)(nil).CorrectFramePins), varargs...)
+}
+
 // GetGame mocks base method.
 func (m *MockGameManager) GetGame(gameId int32) (core.GameInfo, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +140,50 @@ func (mr *MockGameManagerMockRecorder) GetGame(gameId interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGame", reflect.TypeOf((*MockGameManager)(nil).GetGame), gameId)
 }
 
+// GetGameTypes mocks base method.
+func (m *MockGameManager) GetGameTypes() []core.GameTypeInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameTypes")
+	ret0, _ := ret[0].([]core.GameTypeInfo)
+	return ret0
+}
+
+// GetGameTypes indicates an expected call of GetGameTypes.
+func (mr *MockGameManagerMockRecorder) GetGameTypes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameTypes", reflect.TypeOf((*MockGameManager)(nil).GetGameTypes))
+}
+
+// GetSeries mocks base method.
+func (m *MockGameManager) GetSeries(gameId int32) (core.SeriesInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeries", gameId)
+	ret0, _ := ret[0].(core.SeriesInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeries indicates an expected call of GetSeries.
+func (mr *MockGameManagerMockRecorder) GetSeries(gameId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeries", reflect.TypeOf((*MockGameManager)(nil).GetSeries), gameId)
+}
+
+// MarkBlind mocks base method.
+func (m *MockGameManager) MarkBlind(gameId int32, playerIndex int, average *int) (core.GameInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkBlind", gameId, playerIndex, average)
+	ret0, _ := ret[0].(core.GameInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkBlind indicates an expected call of MarkBlind.
+func (mr *MockGameManagerMockRecorder) MarkBlind(gameId, playerIndex, average interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkBlind", reflect.TypeOf((*MockGameManager)(nil).MarkBlind), gameId, playerIndex, average)
+}
+
 // NextFrame mocks base method.
 func (m *MockGameManager) NextFrame(gameId int32) (core.GameInfo, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +199,155 @@ func (mr *MockGameManagerMockRecorder) NextFrame(gameId interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextFrame", reflect.TypeOf((*MockGameManager)(nil).NextFrame), gameId)
 }
 
+// NextPlayerFrame mocks base method.
+func (m *MockGameManager) NextPlayerFrame(gameId int32, playerIndex int) (core.GameInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextPlayerFrame", gameId, playerIndex)
+	ret0, _ := ret[0].(core.GameInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextPlayerFrame indicates an expected call of NextPlayerFrame.
+func (mr *MockGameManagerMockRecorder) NextPlayerFrame(gameId, playerIndex interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextPlayerFrame", reflect.TypeOf((*MockGameManager)(nil).NextPlayerFrame), gameId, playerIndex)
+}
+
+// Redo mocks base method.
+func (m *MockGameManager) Redo(gameId int32) (core.GameInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redo", gameId)
+	ret0, _ := ret[0].(core.GameInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redo indicates an expected call of Redo.
+func (mr *MockGameManagerMockRecorder) Redo(gameId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redo", reflect.TypeOf((*MockGameManager)(nil).Redo), gameId)
+}
+
+// RedoCorrection mocks base method.
+func (m *MockGameManager) RedoCorrection(gameId int32, editor string) (core.GameInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedoCorrection", gameId, editor)
+	ret0, _ := ret[0].(core.GameInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedoCorrection indicates an expected call of RedoCorrection.
+func (mr *MockGameManagerMockRecorder) RedoCorrection(gameId, editor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedoCorrection", reflect.TypeOf((*MockGameManager)(nil).RedoCorrection), gameId, editor)
+}
+
+// Roll mocks base method.
+func (m *MockGameManager) Roll(gameId int32, playerIndex, pins int) (core.GameInfo, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Roll", gameId, playerIndex, pins)
+	ret0, _ := ret[0].(core.GameInfo)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Roll indicates an expected call of Roll.
+func (mr *MockGameManagerMockRecorder) Roll(gameId, playerIndex, pins interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Roll", reflect.TypeOf((*MockGameManager)(nil).Roll), gameId, playerIndex, pins)
+}
+
+// RollBy mocks base method.
+func (m *MockGameManager) RollBy(gameId int32, playerIndex int, bowler string, pins int) (core.GameInfo, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollBy", gameId, playerIndex, bowler, pins)
+	ret0, _ := ret[0].(core.GameInfo)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RollBy indicates an expected call of RollBy.
+func (mr *MockGameManagerMockRecorder) RollBy(gameId, playerIndex, bowler, pins interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollBy", reflect.TypeOf((*MockGameManager)(nil).RollBy), gameId, playerIndex, bowler, pins)
+}
+
+// RollPins mocks base method.
+func (m *MockGameManager) RollPins(gameId int32, playerIndex int, knocked core.PinMask) (core.GameInfo, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollPins", gameId, playerIndex, knocked)
+	ret0, _ := ret[0].(core.GameInfo)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RollPins indicates an expected call of RollPins.
+func (mr *MockGameManagerMockRecorder) RollPins(gameId, playerIndex, knocked interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollPins", reflect.TypeOf((*MockGameManager)(nil).RollPins), gameId, playerIndex, knocked)
+}
+
+// RollPinsBy mocks base method.
+func (m *MockGameManager) RollPinsBy(gameId int32, playerIndex int, bowler string, knocked core.PinMask) (core.GameInfo, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollPinsBy", gameId, playerIndex, bowler, knocked)
+	ret0, _ := ret[0].(core.GameInfo)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RollPinsBy indicates an expected call of RollPinsBy.
+func (mr *MockGameManagerMockRecorder) RollPinsBy(gameId, playerIndex, bowler, knocked interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollPinsBy", reflect.TypeOf((*MockGameManager)(nil).RollPinsBy), gameId, playerIndex, bowler, knocked)
+}
+
+// SetFrameLeaves mocks base method.
+func (m *MockGameManager) SetFrameLeaves(gameId int32, playerIndex int, leaves ...core.PinMask) (core.GameInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{gameId, playerIndex}
+	for _, a := range leaves {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetFrameLeaves", varargs...)
+	ret0, _ := ret[0].(core.GameInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetFrameLeaves indicates an expected call of SetFrameLeaves.
+func (mr *MockGameManagerMockRecorder) SetFrameLeaves(gameId, playerIndex interface{}, leaves ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{gameId, playerIndex}, leaves...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFrameLeaves", reflect.TypeOf((*MockGameManager)(nil).SetFrameLeaves), varargs...)
+}
+
+// SetFramePins mocks base method.
+func (m *MockGameManager) SetFramePins(gameId int32, playerIndex int, knocked ...core.PinMask) (core.GameInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{gameId, playerIndex}
+	for _, a := range knocked {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetFramePins", varargs...)
+	ret0, _ := ret[0].(core.GameInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetFramePins indicates an expected call of SetFramePins.
+func (mr *MockGameManagerMockRecorder) SetFramePins(gameId, playerIndex interface{}, knocked ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{gameId, playerIndex}, knocked...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFramePins", reflect.TypeOf((*MockGameManager)(nil).SetFramePins), varargs...)
+}
+
 // SetFrameResult mocks base method.
 func (m *MockGameManager) SetFrameResult(gameId int32, playerIndex int, pins ...int) (core.GameInfo, error) {
 	m.ctrl.T.Helper()
@@ -86,16 +369,121 @@ func (mr *MockGameManagerMockRecorder) SetFrameResult(gameId, playerIndex interf
 }
 
 // StartGame mocks base method.
-func (m *MockGameManager) StartGame(t configs.GameType, playerNames []string) (core.GameInfo, error) {
+func (m *MockGameManager) StartGame(t configs.GameType, playerNames []string, opts core.GameOptions) (core.GameInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StartGame", t, playerNames)
+	ret := m.ctrl.Call(m, "StartGame", t, playerNames, opts)
 	ret0, _ := ret[0].(core.GameInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // StartGame indicates an expected call of StartGame.
-func (mr *MockGameManagerMockRecorder) StartGame(t, playerNames interface{}) *gomock.Call {
+func (mr *MockGameManagerMockRecorder) StartGame(t, playerNames, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartGame", reflect.TypeOf((*MockGameManager)(nil).StartGame), t, playerNames, opts)
+}
+
+// StartNextGame mocks base method.
+func (m *MockGameManager) StartNextGame(gameId int32) (core.GameInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartNextGame", gameId)
+	ret0, _ := ret[0].(core.GameInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartNextGame indicates an expected call of StartNextGame.
+func (mr *MockGameManagerMockRecorder) StartNextGame(gameId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartNextGame", reflect.TypeOf((*MockGameManager)(nil).StartNextGame), gameId)
+}
+
+// StartRollOff mocks base method.
+func (m *MockGameManager) StartRollOff(gameId int32, place int, format configs.RollOffFormat) (core.GameInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartRollOff", gameId, place, format)
+	ret0, _ := ret[0].(core.GameInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartRollOff indicates an expected call of StartRollOff.
+func (mr *MockGameManagerMockRecorder) StartRollOff(gameId, place, format interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRollOff", reflect.TypeOf((*MockGameManager)(nil).StartRollOff), gameId, place, format)
+}
+
+// SubstitutePartner mocks base method.
+func (m *MockGameManager) SubstitutePartner(gameId int32, playerIndex int, partner, name string, fromFrame int) (core.GameInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubstitutePartner", gameId, playerIndex, partner, name, fromFrame)
+	ret0, _ := ret[0].(core.GameInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubstitutePartner indicates an expected call of SubstitutePartner.
+func (mr *MockGameManagerMockRecorder) SubstitutePartner(gameId, playerIndex, partner, name, fromFrame interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubstitutePartner", reflect.TypeOf((*MockGameManager)(nil).SubstitutePartner), gameId, playerIndex, partner, name, fromFrame)
+}
+
+// SubstitutePlayer mocks base method.
+func (m *MockGameManager) SubstitutePlayer(gameId int32, playerIndex int, name string, fromFrame int) (core.GameInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubstitutePlayer", gameId, playerIndex, name, fromFrame)
+	ret0, _ := ret[0].(core.GameInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubstitutePlayer indicates an expected call of SubstitutePlayer.
+func (mr *MockGameManagerMockRecorder) SubstitutePlayer(gameId, playerIndex, name, fromFrame interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubstitutePlayer", reflect.TypeOf((*MockGameManager)(nil).SubstitutePlayer), gameId, playerIndex, name, fromFrame)
+}
+
+// Undo mocks base method.
+func (m *MockGameManager) Undo(gameId int32) (core.GameInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Undo", gameId)
+	ret0, _ := ret[0].(core.GameInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Undo indicates an expected call of Undo.
+func (mr *MockGameManagerMockRecorder) Undo(gameId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Undo", reflect.TypeOf((*MockGameManager)(nil).Undo), gameId)
+}
+
+// UndoCorrection mocks base method.
+func (m *MockGameManager) UndoCorrection(gameId int32, editor string) (core.GameInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UndoCorrection", gameId, editor)
+	ret0, _ := ret[0].(core.GameInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UndoCorrection indicates an expected call of UndoCorrection.
+func (mr *MockGameManagerMockRecorder) UndoCorrection(gameId, editor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndoCorrection", reflect.TypeOf((*MockGameManager)(nil).UndoCorrection), gameId, editor)
+}
+
+// WithdrawPlayer mocks base method.
+func (m *MockGameManager) WithdrawPlayer(gameId int32, playerIndex int) (core.GameInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawPlayer", gameId, playerIndex)
+	ret0, _ := ret[0].(core.GameInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WithdrawPlayer indicates an expected call of WithdrawPlayer.
+func (mr *MockGameManagerMockRecorder) WithdrawPlayer(gameId, playerIndex interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartGame", reflect.TypeOf((*MockGameManager)(nil).StartGame), t, playerNames)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawPlayer", reflect.TypeOf((*MockGameManager)(nil).WithdrawPlayer), gameId, playerIndex)
 }
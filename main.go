@@ -1,18 +1,61 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
 	"log"
+	"os"
+	"path/filepath"
 
 	"github.com/gin-gonic/gin"
 
+	"bowling-score-tracker/core"
 	"bowling-score-tracker/http_handlers"
 )
 
 func main() {
+	gameTypesDir := flag.String("game_types_dir", "", "directory of JSON files defining custom game types")
+	adminToken := flag.String("admin_token", "", "token required by privileged endpoints, which are disabled if empty")
+	flag.Parse()
+
+	if *gameTypesDir != "" {
+		if err := loadGameTypes(*gameTypesDir); err != nil {
+			log.Fatal("Failed to load game types: ", err)
+		}
+	}
+
 	r := gin.Default()
-	http_handlers.RegisterEndpoints(r)
+	http_handlers.RegisterEndpoints(r, *adminToken)
 
 	if err := r.Run(":80"); err != nil {
 		log.Fatal("Failed to start server: ", err)
 	}
 }
+
+// loadGameTypes registers the custom game types defined in the JSON files of a directory
+func loadGameTypes(dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		var spec core.GameTypeSpec
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err = decoder.Decode(&spec); err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+		if err = core.RegisterGameTypeSpec(spec); err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+	}
+	return nil
+}